@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"sort"
 	"strconv"
 )
 
@@ -40,6 +41,24 @@ func dataSourceRedisCloudCloudAccount() *schema.Resource {
 				Description: "The access key ID associated with the cloud account",
 				Computed:    true,
 			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The current status of the cloud account",
+				Computed:    true,
+			},
+			"most_recent": {
+				Type:        schema.TypeBool,
+				Description: "Whether to select the most recent cloud account, according to sort_by, when several of them match",
+				Optional:    true,
+				Default:     false,
+			},
+			"sort_by": {
+				Type:             schema.TypeString,
+				Description:      "How most_recent selects the cloud account, either by the highest `id` or the last `name` in lexical order",
+				Optional:         true,
+				Default:          "id",
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"id", "name"}, false)),
+			},
 		},
 	}
 }
@@ -53,25 +72,11 @@ func dataSourceRedisCloudCloudAccountRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	var filters []func(account *cloud_accounts.CloudAccount) bool
+	accounts = filterCloudAccounts(accounts, buildCloudAccountFilters(d))
 
-	if v, ok := d.GetOk("exclude_internal_account"); ok && v.(bool) {
-		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
-			return redis.IntValue(account.ID) != 1
-		})
-	}
-	if v, ok := d.GetOk("provider_type"); ok {
-		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
-			return redis.StringValue(account.Provider) == v.(string)
-		})
+	if len(accounts) > 1 && d.Get("most_recent").(bool) {
+		accounts = []*cloud_accounts.CloudAccount{mostRecentCloudAccount(accounts, d.Get("sort_by").(string))}
 	}
-	if v, ok := d.GetOk("name"); ok {
-		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
-			return redis.StringValue(account.Name) == v.(string)
-		})
-	}
-
-	accounts = filterCloudAccounts(accounts, filters)
 
 	if len(accounts) == 0 {
 		return diag.Errorf("Your query returned no results. Please change your search criteria and try again.")
@@ -93,6 +98,9 @@ func dataSourceRedisCloudCloudAccountRead(ctx context.Context, d *schema.Resourc
 	if err := d.Set("access_key_id", redis.StringValue(account.AccessKeyID)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("status", redis.StringValue(account.Status)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -116,3 +124,39 @@ func filterCloudAccount(account *cloud_accounts.CloudAccount, filters []func(acc
 	}
 	return true
 }
+
+// buildCloudAccountFilters builds the filters shared by the cloud account data sources.
+func buildCloudAccountFilters(d *schema.ResourceData) []func(account *cloud_accounts.CloudAccount) bool {
+	var filters []func(account *cloud_accounts.CloudAccount) bool
+
+	if v, ok := d.GetOk("exclude_internal_account"); ok && v.(bool) {
+		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
+			return redis.IntValue(account.ID) != 1
+		})
+	}
+	if v, ok := d.GetOk("provider_type"); ok {
+		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
+			return redis.StringValue(account.Provider) == v.(string)
+		})
+	}
+	if v, ok := d.GetOk("name"); ok {
+		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
+			return redis.StringValue(account.Name) == v.(string)
+		})
+	}
+
+	return filters
+}
+
+// mostRecentCloudAccount returns the cloud account with the highest ID, or with the last name in lexical order.
+func mostRecentCloudAccount(accounts []*cloud_accounts.CloudAccount, sortBy string) *cloud_accounts.CloudAccount {
+	sorted := make([]*cloud_accounts.CloudAccount, len(accounts))
+	copy(sorted, accounts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sortBy == "name" {
+			return redis.StringValue(sorted[i].Name) > redis.StringValue(sorted[j].Name)
+		}
+		return redis.IntValue(sorted[i].ID) > redis.IntValue(sorted[j].ID)
+	})
+	return sorted[0]
+}
@@ -6,7 +6,10 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDataSourceRedisCloudCloudAccount_basic(t *testing.T) {
@@ -38,3 +41,13 @@ data "rediscloud_cloud_account" "test" {
   name = "%s"
 }
 `
+
+func TestMostRecentCloudAccount(t *testing.T) {
+	accounts := []*cloud_accounts.CloudAccount{
+		{ID: redis.Int(2), Name: redis.String("aws-2026")},
+		{ID: redis.Int(3), Name: redis.String("aws-2025")},
+	}
+
+	assert.Equal(t, 3, redis.IntValue(mostRecentCloudAccount(accounts, "id").ID))
+	assert.Equal(t, 2, redis.IntValue(mostRecentCloudAccount(accounts, "name").ID))
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRedisCloudCloudAccounts() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Cloud Accounts data source allows access to all the Cloud Account configurations which match the filters.",
+		ReadContext: dataSourceRedisCloudCloudAccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_internal_account": {
+				Type:        schema.TypeBool,
+				Description: "Whether to exclude the Redis Labs internal cloud account.",
+				Optional:    true,
+				Default:     false,
+			},
+			"provider_type": {
+				Type:             schema.TypeString,
+				Description:      "The cloud provider of the cloud accounts, (either `AWS` or `GCP`)",
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(cloud_accounts.ProviderValues(), false)),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the cloud accounts to filter returned cloud accounts",
+				Optional:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the cloud accounts to filter returned cloud accounts",
+				Optional:    true,
+			},
+			"cloud_accounts": {
+				Type:        schema.TypeList,
+				Description: "The cloud accounts which match the filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "Identifier of the cloud account",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "A meaningful name to identify the cloud account",
+							Computed:    true,
+						},
+						"provider_type": {
+							Type:        schema.TypeString,
+							Description: "The cloud provider of the cloud account, (either `AWS` or `GCP`)",
+							Computed:    true,
+						},
+						"access_key_id": {
+							Type:        schema.TypeString,
+							Description: "The access key ID associated with the cloud account",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The current status of the cloud account",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRedisCloudCloudAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient)
+
+	accounts, err := client.client.CloudAccount.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filters := buildCloudAccountFilters(d)
+	if v, ok := d.GetOk("status"); ok {
+		filters = append(filters, func(account *cloud_accounts.CloudAccount) bool {
+			return redis.StringValue(account.Status) == v.(string)
+		})
+	}
+
+	accounts = filterCloudAccounts(accounts, filters)
+
+	var result []map[string]interface{}
+	for _, account := range accounts {
+		result = append(result, map[string]interface{}{
+			"id":            strconv.Itoa(redis.IntValue(account.ID)),
+			"name":          redis.StringValue(account.Name),
+			"provider_type": redis.StringValue(account.Provider),
+			"access_key_id": redis.StringValue(account.AccessKeyID),
+			"status":        redis.StringValue(account.Status),
+		})
+	}
+
+	d.SetId("ALL")
+	if err := d.Set("cloud_accounts", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRedisCloudCloudAccounts_basic(t *testing.T) {
+	name := os.Getenv("AWS_TEST_CLOUD_ACCOUNT_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccAwsPreExistingCloudAccountPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      nil, // test doesn't create a resource at the moment, so don't need to check anything
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDatasourceRedisCloudCloudAccountsDataSource, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rediscloud_cloud_accounts.test", "cloud_accounts.#", "1"),
+					resource.TestMatchResourceAttr(
+						"data.rediscloud_cloud_accounts.test", "cloud_accounts.0.id", regexp.MustCompile("^\\d+$")),
+					resource.TestCheckResourceAttr("data.rediscloud_cloud_accounts.test", "cloud_accounts.0.provider_type", "AWS"),
+					resource.TestCheckResourceAttr("data.rediscloud_cloud_accounts.test", "cloud_accounts.0.name", name),
+					resource.TestCheckResourceAttr("data.rediscloud_cloud_accounts.test", "cloud_accounts.0.status", "active"),
+					resource.TestCheckResourceAttrSet("data.rediscloud_cloud_accounts.test", "cloud_accounts.0.access_key_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDatasourceRedisCloudCloudAccountsDataSource = `
+data "rediscloud_cloud_accounts" "test" {
+  exclude_internal_account = true
+  provider_type = "AWS"
+  name = "%s"
+}
+`
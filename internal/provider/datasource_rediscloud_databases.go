@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRedisCloudDatabases() *schema.Resource {
+	// Each database has the same attributes as the rediscloud_database data source, along with its IDs and status.
+	databaseSchema := dataSourceRedisCloudDatabase().Schema
+	for _, key := range []string{"subscription_id", "name", "protocol", "region"} {
+		databaseSchema[key] = &schema.Schema{
+			Description: databaseSchema[key].Description,
+			Type:        schema.TypeString,
+			Computed:    true,
+		}
+	}
+	databaseSchema["id"] = &schema.Schema{
+		Description: "Identifier of the database, in the format `<subscription ID>/<database ID>`",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	databaseSchema["db_id"] = &schema.Schema{
+		Description: "Identifier of the database within its subscription",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+	databaseSchema["status"] = &schema.Schema{
+		Description: "Current status of the database",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "The Databases data source allows access to the details of all the databases within a subscription, or within every subscription of your Redis Enterprise Cloud account, which match the filters.",
+		ReadContext: dataSourceRedisCloudDatabasesRead,
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Description:      "ID of the subscription to list the databases of. Databases from all subscriptions are listed if it is omitted",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
+			},
+			"name_regex": {
+				Description:      "A regular expression to filter the databases by name",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringIsValidRegExp),
+			},
+			"protocol": {
+				Description:      "The protocol of the databases to filter returned databases",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(databases.ProtocolValues(), false)),
+			},
+			"region": {
+				Description: "The region of the databases to filter returned databases",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"module": {
+				Description: "The name of a module which must be enabled on the databases to be returned",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description: "The status of the databases to filter returned databases",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"databases": {
+				Description: "The databases which match the filters",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: databaseSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRedisCloudDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	api := meta.(*apiClient)
+
+	id := "ALL"
+	var subIds []int
+	if v, ok := d.GetOk("subscription_id"); ok {
+		subId, err := strconv.Atoi(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		subIds = append(subIds, subId)
+		id = v.(string)
+	} else {
+		subs, err := api.client.Subscription.List(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, sub := range subs {
+			subIds = append(subIds, redis.IntValue(sub.ID))
+		}
+	}
+
+	filters := buildDatabasesFilters(d)
+
+	// The databases are only listed, rather than retrieved one by one, as the list contains all of their attributes.
+	var result []map[string]interface{}
+	for _, subId := range subIds {
+		dbs, err := filterDatabases(api.client.Database.List(ctx, subId), filters)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, db := range dbs {
+			flattened, err := flattenDatabase(subId, db, api.hashSecrets)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			result = append(result, flattened)
+		}
+	}
+
+	d.SetId(id)
+	if err := d.Set("databases", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func buildDatabasesFilters(d *schema.ResourceData) []func(db *databases.Database) bool {
+	var filters []func(db *databases.Database) bool
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex := regexp.MustCompile(v.(string))
+		filters = append(filters, func(db *databases.Database) bool {
+			return nameRegex.MatchString(redis.StringValue(db.Name))
+		})
+	}
+	if v, ok := d.GetOk("protocol"); ok {
+		filters = append(filters, func(db *databases.Database) bool {
+			return redis.StringValue(db.Protocol) == v.(string)
+		})
+	}
+	if v, ok := d.GetOk("region"); ok {
+		filters = append(filters, func(db *databases.Database) bool {
+			return redis.StringValue(db.Region) == v.(string)
+		})
+	}
+	if v, ok := d.GetOk("module"); ok {
+		filters = append(filters, func(db *databases.Database) bool {
+			for _, module := range db.Modules {
+				if redis.StringValue(module.Name) == v.(string) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	if v, ok := d.GetOk("status"); ok {
+		filters = append(filters, func(db *databases.Database) bool {
+			return redis.StringValue(db.Status) == v.(string)
+		})
+	}
+
+	return filters
+}
+
+// flattenDatabase builds an element of the databases list. The password is left out when hashSecrets is enabled, as
+// there is no previous state to compare a hash with.
+func flattenDatabase(subId int, db *databases.Database, hashSecrets bool) (map[string]interface{}, error) {
+	password := ""
+	enableTLS := false
+	if db.Security != nil {
+		if !hashSecrets {
+			password = redis.StringValue(db.Security.Password)
+		}
+		enableTLS = redis.BoolValue(db.Security.EnableTls)
+	}
+
+	publicURL, privateURL, connectionJSON, err := buildConnectionDetails(db, password)
+	if err != nil {
+		return nil, err
+	}
+
+	throughputMeasurementBy := ""
+	throughputMeasurementValue := 0
+	if db.ThroughputMeasurement != nil {
+		throughputMeasurementBy = redis.StringValue(db.ThroughputMeasurement.By)
+		throughputMeasurementValue = redis.IntValue(db.ThroughputMeasurement.Value)
+	}
+
+	var replicaOf []string
+	if db.ReplicaOf != nil {
+		replicaOf = redis.StringSliceValue(db.ReplicaOf.Endpoints...)
+	}
+
+	var regexRules []*databases.RegexRule
+	if db.Clustering != nil {
+		regexRules = db.Clustering.RegexRules
+	}
+
+	return map[string]interface{}{
+		"id":                           buildResourceId(subId, redis.IntValue(db.ID)),
+		"subscription_id":              strconv.Itoa(subId),
+		"db_id":                        redis.IntValue(db.ID),
+		"name":                         redis.StringValue(db.Name),
+		"protocol":                     redis.StringValue(db.Protocol),
+		"region":                       redis.StringValue(db.Region),
+		"status":                       redis.StringValue(db.Status),
+		"memory_limit_in_gb":           redis.Float64Value(db.MemoryLimitInGB),
+		"support_oss_cluster_api":      redis.BoolValue(db.SupportOSSClusterAPI),
+		"data_persistence":             redis.StringValue(db.DataPersistence),
+		"data_eviction":                redis.StringValue(db.DataEvictionPolicy),
+		"replication":                  redis.BoolValue(db.Replication),
+		"throughput_measurement_by":    throughputMeasurementBy,
+		"throughput_measurement_value": throughputMeasurementValue,
+		"shard_count":                  shardCount(db),
+		"effective_throughput_ops":     effectiveThroughputOps(db),
+		"password":                     password,
+		"public_endpoint":              redis.StringValue(db.PublicEndpoint),
+		"private_endpoint":             redis.StringValue(db.PrivateEndpoint),
+		"enable_tls":                   enableTLS,
+		"public_connection_url":        publicURL,
+		"private_connection_url":       privateURL,
+		"connection_json":              connectionJSON,
+		"replica_of":                   replicaOf,
+		"alert":                        flattenAlerts(db.Alerts),
+		"module":                       flattenModules(db.Modules),
+		"hashing_policy":               flattenRegexRules(regexRules),
+	}, nil
+}
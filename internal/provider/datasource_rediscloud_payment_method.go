@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"regexp"
+	"sort"
 	"strconv"
 	"time"
 )
@@ -39,6 +40,36 @@ func dataSourceRedisCloudPaymentMethod() *schema.Resource {
 
 				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d{4}$"), "must contain last four numbers of the card of the payment method")),
 			},
+			"payment_method_id": {
+				Description:      "Identifier of the payment method",
+				Optional:         true,
+				Computed:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
+			},
+			"most_recent": {
+				Description: "Whether to select the most recent payment method, according to sort_by, when several of them match",
+				Optional:    true,
+				Default:     false,
+				Type:        schema.TypeBool,
+			},
+			"sort_by": {
+				Description:      "How most_recent selects the payment method, either by the latest `expiration` date or the highest `id`",
+				Optional:         true,
+				Default:          "expiration",
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"expiration", "id"}, false)),
+			},
+			"expiration_month": {
+				Description: "Month that the card of the payment method expires",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
+			"expiration_year": {
+				Description: "Year that the card of the payment method expires",
+				Computed:    true,
+				Type:        schema.TypeInt,
+			},
 		},
 	}
 }
@@ -53,41 +84,11 @@ func dataSourceRedisCloudPaymentMethodRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	var filters []func(method *account.PaymentMethod) bool
+	methods = filterPaymentMethods(methods, buildPaymentMethodFilters(d))
 
-	if exclude, ok := d.GetOk("exclude_expired"); ok && exclude.(bool) {
-		now := time.Now()
-		filters = append(filters, func(method *account.PaymentMethod) bool {
-			if redis.IntValue(method.ExpirationYear) < now.Year() {
-				// Expiration year is last year, so it must already have expired and no point checking the month
-				return false
-			}
-
-			if redis.IntValue(method.ExpirationYear) > now.Year() {
-				// Expiration year is next year, so it cannot have expired and no point checking the month
-				return true
-			}
-
-			// Expiration year is this year, so we do have to check the month
-			if redis.IntValue(method.ExpirationMonth) < int(now.Month()) {
-				return false
-			}
-
-			return true
-		})
+	if len(methods) > 1 && d.Get("most_recent").(bool) {
+		methods = []*account.PaymentMethod{mostRecentPaymentMethod(methods, d.Get("sort_by").(string))}
 	}
-	if card, ok := d.GetOk("card_type"); ok {
-		filters = append(filters, func(method *account.PaymentMethod) bool {
-			return redis.StringValue(method.Type) == card
-		})
-	}
-	if fourNumbers, ok := d.GetOk("last_four_numbers"); ok {
-		filters = append(filters, func(method *account.PaymentMethod) bool {
-			return formattedCardNumber(method) == fourNumbers
-		})
-	}
-
-	methods = filterPaymentMethods(methods, filters)
 
 	if len(methods) == 0 {
 		return diag.Errorf("Your query returned no results. Please change your search criteria and try again.")
@@ -106,6 +107,15 @@ func dataSourceRedisCloudPaymentMethodRead(ctx context.Context, d *schema.Resour
 	if err := d.Set("last_four_numbers", formattedCardNumber(method)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("payment_method_id", strconv.Itoa(redis.IntValue(method.ID))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expiration_month", redis.IntValue(method.ExpirationMonth)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expiration_year", redis.IntValue(method.ExpirationYear)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -133,3 +143,64 @@ func filterPaymentMethod(method *account.PaymentMethod, filters []func(method *a
 	}
 	return true
 }
+
+// buildPaymentMethodFilters builds the filters shared by the payment method data sources.
+func buildPaymentMethodFilters(d *schema.ResourceData) []func(method *account.PaymentMethod) bool {
+	var filters []func(method *account.PaymentMethod) bool
+
+	if exclude, ok := d.GetOk("exclude_expired"); ok && exclude.(bool) {
+		now := time.Now()
+		filters = append(filters, func(method *account.PaymentMethod) bool {
+			return !paymentMethodExpired(method, now)
+		})
+	}
+	if card, ok := d.GetOk("card_type"); ok {
+		filters = append(filters, func(method *account.PaymentMethod) bool {
+			return redis.StringValue(method.Type) == card
+		})
+	}
+	if fourNumbers, ok := d.GetOk("last_four_numbers"); ok {
+		filters = append(filters, func(method *account.PaymentMethod) bool {
+			return formattedCardNumber(method) == fourNumbers
+		})
+	}
+	if v, ok := d.GetOk("payment_method_id"); ok {
+		filters = append(filters, func(method *account.PaymentMethod) bool {
+			return strconv.Itoa(redis.IntValue(method.ID)) == v.(string)
+		})
+	}
+
+	return filters
+}
+
+func paymentMethodExpired(method *account.PaymentMethod, now time.Time) bool {
+	if redis.IntValue(method.ExpirationYear) < now.Year() {
+		// Expiration year is last year, so it must already have expired and no point checking the month
+		return true
+	}
+
+	if redis.IntValue(method.ExpirationYear) > now.Year() {
+		// Expiration year is next year, so it cannot have expired and no point checking the month
+		return false
+	}
+
+	// Expiration year is this year, so we do have to check the month
+	return redis.IntValue(method.ExpirationMonth) < int(now.Month())
+}
+
+// mostRecentPaymentMethod returns the payment method which expires last, or which has the highest ID.
+func mostRecentPaymentMethod(methods []*account.PaymentMethod, sortBy string) *account.PaymentMethod {
+	key := func(method *account.PaymentMethod) int {
+		if sortBy == "id" {
+			return redis.IntValue(method.ID)
+		}
+		return redis.IntValue(method.ExpirationYear)*12 + redis.IntValue(method.ExpirationMonth)
+	}
+
+	sorted := make([]*account.PaymentMethod, len(methods))
+	copy(sorted, methods)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return key(sorted[i]) > key(sorted[j])
+	})
+	return sorted[0]
+}
@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 	"regexp"
 	"testing"
+	"time"
 )
 
 func TestAccDataSourceRedisCloudPaymentMethod_basic(t *testing.T) {
@@ -33,3 +37,35 @@ data "rediscloud_payment_method" "foo" {
   card_type = "Visa"
 }
 `
+
+func TestMostRecentPaymentMethod(t *testing.T) {
+	methods := []*account.PaymentMethod{
+		{ID: redis.Int(3), ExpirationMonth: redis.Int(12), ExpirationYear: redis.Int(2025)},
+		{ID: redis.Int(1), ExpirationMonth: redis.Int(2), ExpirationYear: redis.Int(2027)},
+		{ID: redis.Int(2), ExpirationMonth: redis.Int(1), ExpirationYear: redis.Int(2027)},
+	}
+
+	assert.Equal(t, 1, redis.IntValue(mostRecentPaymentMethod(methods, "expiration").ID))
+	assert.Equal(t, 3, redis.IntValue(mostRecentPaymentMethod(methods, "id").ID))
+	// The order of the list isn't changed.
+	assert.Equal(t, 3, redis.IntValue(methods[0].ID))
+}
+
+func TestPaymentMethodExpired(t *testing.T) {
+	now := time.Date(2026, time.June, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		month    int
+		year     int
+		expected bool
+	}{
+		{12, 2025, true},
+		{5, 2026, true},
+		{6, 2026, false},
+		{1, 2027, false},
+	}
+	for _, test := range tests {
+		method := &account.PaymentMethod{ExpirationMonth: redis.Int(test.month), ExpirationYear: redis.Int(test.year)}
+		assert.Equal(t, test.expected, paymentMethodExpired(method, now), "%d/%d", test.month, test.year)
+	}
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRedisCloudPaymentMethods() *schema.Resource {
+	return &schema.Resource{
+		Description: "The Payment Methods data source allows access to all the Payment Methods configured against your Redis Enterprise Cloud account which match the filters.",
+		ReadContext: dataSourceRedisCloudPaymentMethodsRead,
+
+		Schema: map[string]*schema.Schema{
+			"card_type": {
+				Description: "Type of card of the payment methods to filter returned payment methods, such as `Visa`",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			"exclude_expired": {
+				Description: "Whether to exclude any expired cards or not",
+				Optional:    true,
+				Default:     true,
+				Type:        schema.TypeBool,
+			},
+			"last_four_numbers": {
+				Description: "Last four numbers of the card of the payment methods to filter returned payment methods",
+				Optional:    true,
+				Type:        schema.TypeString,
+
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d{4}$"), "must contain last four numbers of the card of the payment method")),
+			},
+			"payment_method_id": {
+				Description:      "Identifier of the payment method to filter returned payment methods",
+				Optional:         true,
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
+			},
+			"payment_methods": {
+				Description: "The payment methods which match the filters",
+				Computed:    true,
+				Type:        schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "Identifier of the payment method",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"card_type": {
+							Description: "Type of card of the payment method, such as `Visa`",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"last_four_numbers": {
+							Description: "Last four numbers of the card of the payment method",
+							Computed:    true,
+							Type:        schema.TypeString,
+						},
+						"expiration_month": {
+							Description: "Month that the card of the payment method expires",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"expiration_year": {
+							Description: "Year that the card of the payment method expires",
+							Computed:    true,
+							Type:        schema.TypeInt,
+						},
+						"expired": {
+							Description: "Whether the card of the payment method has expired",
+							Computed:    true,
+							Type:        schema.TypeBool,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRedisCloudPaymentMethodsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*apiClient)
+
+	methods, err := client.client.Account.ListPaymentMethods(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	methods = filterPaymentMethods(methods, buildPaymentMethodFilters(d))
+
+	now := time.Now()
+	var result []map[string]interface{}
+	for _, method := range methods {
+		result = append(result, map[string]interface{}{
+			"id":                strconv.Itoa(redis.IntValue(method.ID)),
+			"card_type":         redis.StringValue(method.Type),
+			"last_four_numbers": formattedCardNumber(method),
+			"expiration_month":  redis.IntValue(method.ExpirationMonth),
+			"expiration_year":   redis.IntValue(method.ExpirationYear),
+			"expired":           paymentMethodExpired(method, now),
+		})
+	}
+
+	d.SetId("ALL")
+	if err := d.Set("payment_methods", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
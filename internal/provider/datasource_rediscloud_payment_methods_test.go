@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceRedisCloudPaymentMethods_basic(t *testing.T) {
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      nil, // payment method isn't managed by this provider
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRedisCloudPaymentMethods,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.rediscloud_payment_methods.foo", "payment_methods.0.id", regexp.MustCompile("^\\d+$")),
+					resource.TestCheckResourceAttr(
+						"data.rediscloud_payment_methods.foo", "payment_methods.0.card_type", "Visa"),
+					resource.TestCheckResourceAttr(
+						"data.rediscloud_payment_methods.foo", "payment_methods.0.expired", "false"),
+					resource.TestCheckResourceAttrSet(
+						"data.rediscloud_payment_methods.foo", "payment_methods.0.expiration_year"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceRedisCloudPaymentMethods = `
+data "rediscloud_payment_methods" "foo" {
+  card_type = "Visa"
+}
+`
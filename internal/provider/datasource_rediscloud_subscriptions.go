@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/cloud_accounts"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceRedisCloudSubscriptions() *schema.Resource {
+	// Each subscription has the same attributes as the rediscloud_subscription data source, along with its ID.
+	subscriptionSchema := dataSourceRedisCloudSubscription().Schema
+	subscriptionSchema["id"] = &schema.Schema{
+		Description: "Identifier of the subscription",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	subscriptionSchema["name"] = &schema.Schema{
+		Description: "The name of the subscription",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "The Subscriptions data source allows access to the details of all the subscriptions within your Redis Enterprise Cloud account which match the filters.",
+		ReadContext: dataSourceRedisCloudSubscriptionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Description:      "A regular expression to filter the subscriptions by name",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringIsValidRegExp),
+			},
+			"status": {
+				Description: "The status of the subscriptions to filter returned subscriptions",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"cloud_provider": {
+				Description:      "The cloud provider of the subscriptions to filter returned subscriptions, (either `AWS` or `GCP`)",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(cloud_accounts.ProviderValues(), false)),
+			},
+			"region": {
+				Description: "A region which the subscriptions must be deployed in to be returned",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"payment_method_id": {
+				Description:      "The payment method of the subscriptions to filter returned subscriptions",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
+			},
+			"memory_storage": {
+				Description:      "The memory storage of the subscriptions to filter returned subscriptions, either ‘ram’ or 'ram-and-flash’",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(databases.MemoryStorageValues(), false)),
+			},
+			"subscriptions": {
+				Description: "The subscriptions which match the filters",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: subscriptionSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRedisCloudSubscriptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	api := meta.(*apiClient)
+
+	subs, err := api.client.Subscription.List(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	subs = filterSubscriptions(subs, buildSubscriptionsFilters(d))
+
+	var result []map[string]interface{}
+	for _, sub := range subs {
+		result = append(result, flattenSubscription(sub))
+	}
+
+	d.SetId("ALL")
+	if err := d.Set("subscriptions", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func buildSubscriptionsFilters(d *schema.ResourceData) []func(sub *subscriptions.Subscription) bool {
+	var filters []func(sub *subscriptions.Subscription) bool
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex := regexp.MustCompile(v.(string))
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			return nameRegex.MatchString(redis.StringValue(sub.Name))
+		})
+	}
+	if v, ok := d.GetOk("status"); ok {
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			return redis.StringValue(sub.Status) == v.(string)
+		})
+	}
+	if v, ok := d.GetOk("cloud_provider"); ok {
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			for _, cloudDetail := range sub.CloudDetails {
+				if redis.StringValue(cloudDetail.Provider) == v.(string) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+	if v, ok := d.GetOk("region"); ok {
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			for _, cloudDetail := range sub.CloudDetails {
+				for _, region := range cloudDetail.Regions {
+					if redis.StringValue(region.Region) == v.(string) {
+						return true
+					}
+				}
+			}
+			return false
+		})
+	}
+	if v, ok := d.GetOk("payment_method_id"); ok {
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			return sub.PaymentMethodID != nil && strconv.Itoa(redis.IntValue(sub.PaymentMethodID)) == v.(string)
+		})
+	}
+	if v, ok := d.GetOk("memory_storage"); ok {
+		filters = append(filters, func(sub *subscriptions.Subscription) bool {
+			return redis.StringValue(sub.MemoryStorage) == v.(string)
+		})
+	}
+
+	return filters
+}
+
+func flattenSubscription(sub *subscriptions.Subscription) map[string]interface{} {
+	paymentMethodID := ""
+	if sub.PaymentMethodID != nil {
+		paymentMethodID = strconv.Itoa(redis.IntValue(sub.PaymentMethodID))
+	}
+
+	return map[string]interface{}{
+		"id":                  strconv.Itoa(redis.IntValue(sub.ID)),
+		"name":                redis.StringValue(sub.Name),
+		"payment_method":      redis.StringValue(sub.PaymentMethod),
+		"payment_method_id":   paymentMethodID,
+		"memory_storage":      redis.StringValue(sub.MemoryStorage),
+		"number_of_databases": redis.IntValue(sub.NumberOfDatabases),
+		"cloud_provider":      flattenCloudDetails(sub.CloudDetails, false),
+		"status":              redis.StringValue(sub.Status),
+	}
+}
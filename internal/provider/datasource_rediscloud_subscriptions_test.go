@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/subscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccDataSourceRedisCloudSubscriptions_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+
+	dataSourceName := "data.rediscloud_subscriptions.example"
+	testCloudAccountName := os.Getenv("AWS_TEST_CLOUD_ACCOUNT_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); testAccAwsPreExistingCloudAccountPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testAccDatasourceRedisCloudSubscription, testCloudAccountName, name),
+			},
+			{
+				Config: fmt.Sprintf(testAccDatasourceRedisCloudSubscriptionsDataSource, name) + fmt.Sprintf(testAccDatasourceRedisCloudSubscription, testCloudAccountName, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriptions.0.id", "rediscloud_subscription.example", "id"),
+					resource.TestMatchResourceAttr(dataSourceName, "subscriptions.0.name", regexp.MustCompile(name)),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.memory_storage", "ram"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.cloud_provider.0.provider", "AWS"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.cloud_provider.0.region.0.region", "eu-west-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subscriptions.0.status", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildSubscriptionsFilters(t *testing.T) {
+	newSubscription := func(name string, provider string, region string, paymentMethodID int) *subscriptions.Subscription {
+		return &subscriptions.Subscription{
+			Name:            redis.String(name),
+			Status:          redis.String("active"),
+			PaymentMethodID: redis.Int(paymentMethodID),
+			MemoryStorage:   redis.String("ram"),
+			CloudDetails: []*subscriptions.CloudDetail{
+				{
+					Provider: redis.String(provider),
+					Regions:  []*subscriptions.Region{{Region: redis.String(region)}},
+				},
+			},
+		}
+	}
+	subs := []*subscriptions.Subscription{
+		newSubscription("production-a", "AWS", "eu-west-1", 1),
+		newSubscription("production-b", "GCP", "europe-west1", 1),
+		newSubscription("staging", "AWS", "eu-west-1", 2),
+	}
+
+	tests := []struct {
+		filters  map[string]interface{}
+		expected []string
+	}{
+		{map[string]interface{}{}, []string{"production-a", "production-b", "staging"}},
+		{map[string]interface{}{"name_regex": "^production-"}, []string{"production-a", "production-b"}},
+		{map[string]interface{}{"cloud_provider": "AWS"}, []string{"production-a", "staging"}},
+		{map[string]interface{}{"region": "europe-west1"}, []string{"production-b"}},
+		{map[string]interface{}{"payment_method_id": "2"}, []string{"staging"}},
+		{map[string]interface{}{"name_regex": "production", "region": "eu-west-1"}, []string{"production-a"}},
+		{map[string]interface{}{"status": "pending"}, nil},
+	}
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, dataSourceRedisCloudSubscriptions().Schema, test.filters)
+
+		var actual []string
+		for _, sub := range filterSubscriptions(subs, buildSubscriptionsFilters(d)) {
+			actual = append(actual, redis.StringValue(sub.Name))
+		}
+		assert.Equal(t, test.expected, actual, "%v", test.filters)
+	}
+}
+
+const testAccDatasourceRedisCloudSubscriptionsDataSource = `
+
+data "rediscloud_subscriptions" "example" {
+  name_regex = "^%s$"
+  cloud_provider = "AWS"
+  region = "eu-west-1"
+}
+`
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewDatabaseCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &databaseCredentialsEphemeralResource{}
+}
+
+var _ ephemeral.EphemeralResourceWithConfigure = &databaseCredentialsEphemeralResource{}
+
+// databaseCredentialsEphemeralResource retrieves the credentials of a database without storing them in the plan or
+// the state, unlike the password attributes of the rediscloud_database data source.
+type databaseCredentialsEphemeralResource struct {
+	api *apiClient
+}
+
+type databaseCredentialsModel struct {
+	SubscriptionId       types.String `tfsdk:"subscription_id"`
+	DbId                 types.Int64  `tfsdk:"db_id"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	PublicEndpoint       types.String `tfsdk:"public_endpoint"`
+	PrivateEndpoint      types.String `tfsdk:"private_endpoint"`
+	PublicConnectionUrl  types.String `tfsdk:"public_connection_url"`
+	PrivateConnectionUrl types.String `tfsdk:"private_connection_url"`
+}
+
+func (r *databaseCredentialsEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_credentials"
+}
+
+func (r *databaseCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The Database Credentials ephemeral resource retrieves the credentials of a database, without storing them in the plan or the state.",
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the subscription that the database belongs to",
+				Required:            true,
+			},
+			"db_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the database within the subscription",
+				Required:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The user that the password belongs to - not present on `memcached` protocol databases",
+				Computed:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password used to access the database - not present on `memcached` protocol databases",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"public_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Public endpoint to access the database",
+				Computed:            true,
+			},
+			"private_endpoint": schema.StringAttribute{
+				MarkdownDescription: "Private endpoint to access the database",
+				Computed:            true,
+			},
+			"public_connection_url": schema.StringAttribute{
+				MarkdownDescription: "URL, including the credentials, to connect to the database through its public endpoint",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"private_connection_url": schema.StringAttribute{
+				MarkdownDescription: "URL, including the credentials, to connect to the database through its private endpoint",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *databaseCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// The provider hasn't been configured yet when the configuration is validated.
+	if req.ProviderData == nil {
+		return
+	}
+
+	api, ok := req.ProviderData.(*apiClient)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("Expected *apiClient, got %T", req.ProviderData))
+		return
+	}
+	r.api = api
+}
+
+func (r *databaseCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data databaseCredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subId, err := strconv.Atoi(data.SubscriptionId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("subscription_id"), "Invalid subscription ID", "must be a number")
+		return
+	}
+
+	db, err := r.api.client.Database.Get(ctx, subId, int(data.DbId.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve the database", err.Error())
+		return
+	}
+
+	setDatabaseCredentials(&data, db)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// setDatabaseCredentials fills in the computed attributes of the model from the database.
+func setDatabaseCredentials(data *databaseCredentialsModel, db *databases.Database) {
+	protocol := redis.StringValue(db.Protocol)
+	publicEndpoint := redis.StringValue(db.PublicEndpoint)
+	privateEndpoint := redis.StringValue(db.PrivateEndpoint)
+
+	// Only db with the "redis" protocol returns the password, memcached databases don't have one.
+	password := ""
+	enableTLS := false
+	if db.Security != nil {
+		if protocol == "redis" {
+			password = redis.StringValue(db.Security.Password)
+		}
+		enableTLS = redis.BoolValue(db.Security.EnableTls)
+	}
+
+	data.Username = types.StringNull()
+	data.Password = types.StringNull()
+	if password != "" {
+		data.Username = types.StringValue(defaultDatabaseUsername)
+		data.Password = types.StringValue(password)
+	}
+	data.PublicEndpoint = types.StringValue(publicEndpoint)
+	data.PrivateEndpoint = types.StringValue(privateEndpoint)
+	data.PublicConnectionUrl = types.StringValue(buildConnectionURL(protocol, publicEndpoint, password, enableTLS))
+	data.PrivateConnectionUrl = types.StringValue(buildConnectionURL(protocol, privateEndpoint, password, enableTLS))
+}
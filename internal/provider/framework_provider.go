@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	rediscloud_api "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NewFramework returns the part of the provider built on the plugin framework, which is served alongside the SDK
+// provider returned by New. Its schema must be identical to the SDK provider's, as both receive the same configuration.
+func NewFramework(version string) func() fwprovider.Provider {
+	return func() fwprovider.Provider {
+		return &frameworkProvider{version: version}
+	}
+}
+
+var _ fwprovider.ProviderWithEphemeralResources = &frameworkProvider{}
+var _ fwprovider.ProviderWithFunctions = &frameworkProvider{}
+
+type frameworkProvider struct {
+	version string
+}
+
+type frameworkProviderModel struct {
+	Url         types.String `tfsdk:"url"`
+	ApiKey      types.String `tfsdk:"api_key"`
+	SecretKey   types.String `tfsdk:"secret_key"`
+	HashSecrets types.Bool   `tfsdk:"hash_secrets"`
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ fwprovider.MetadataRequest, resp *fwprovider.MetadataResponse) {
+	resp.TypeName = "rediscloud"
+	resp.Version = p.version
+}
+
+func (p *frameworkProvider) Schema(_ context.Context, _ fwprovider.SchemaRequest, resp *fwprovider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("This is the URL of Redis Cloud and will default to `https://api.redislabs.com/v1`. This can also be set by the `%s` environment variable.", RedisCloudUrlEnvVar),
+				Optional:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("This is the Redis Cloud API key. It must be provided but can also be set by the `%s` environment variable.", rediscloud_api.AccessKeyEnvVar),
+				Optional:            true,
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("This is the Redis Cloud API secret key. It must be provided but can also be set by the `%s` environment variable.", rediscloud_api.SecretKeyEnvVar),
+				Optional:            true,
+			},
+			"hash_secrets": schema.BoolAttribute{
+				MarkdownDescription: "Whether to store only a salted hash of database passwords and cloud account credentials in the state, instead of the plaintext. Can be overridden by the `hash_secrets` attribute of each resource.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(ctx context.Context, req fwprovider.ConfigureRequest, resp *fwprovider.ConfigureResponse) {
+	var config frameworkProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The SDK provider reads the default URL from the environment with a DefaultFunc, which the framework doesn't have.
+	url := config.Url.ValueString()
+	if config.Url.IsNull() {
+		url = os.Getenv(RedisCloudUrlEnvVar)
+	}
+
+	userAgent := fmt.Sprintf("Terraform/%s (+https://www.terraform.io) Terraform-Plugin-Framework terraform-provider-rediscloud/%s", req.TerraformVersion, p.version)
+
+	client, err := newApiClient(userAgent, url, config.ApiKey.ValueString(), config.SecretKey.ValueString(), config.HashSecrets.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create the Redis Cloud client", err.Error())
+		return
+	}
+
+	resp.DataSourceData = client
+	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDatabaseCredentialsEphemeralResource,
+	}
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCidrAllowlistMergeFunction,
+		NewConnectionUrlFunction,
+		NewOpsToShardsFunction,
+		NewParseDatabaseIdFunction,
+		NewShardsToOpsFunction,
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewCidrAllowlistMergeFunction() function.Function {
+	return &cidrAllowlistMergeFunction{}
+}
+
+var _ function.Function = &cidrAllowlistMergeFunction{}
+
+type cidrAllowlistMergeFunction struct{}
+
+func (f *cidrAllowlistMergeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_allowlist_merge"
+}
+
+func (f *cidrAllowlistMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Merge lists of CIDR blocks into a single allowlist",
+		MarkdownDescription: "Merges lists of CIDR blocks, such as the `source_ips` of a database, into a single sorted list without duplicates. The address of each block is normalised, so that `10.0.0.1/16` and `10.0.0.0/16` are merged.",
+		VariadicParameter: function.ListParameter{
+			Name:                "lists",
+			MarkdownDescription: "The lists of CIDR blocks to merge",
+			ElementType:         types.StringType,
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *cidrAllowlistMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var lists [][]string
+	resp.Error = req.Arguments.Get(ctx, &lists)
+	if resp.Error != nil {
+		return
+	}
+
+	merged, position, err := mergeCidrAllowlists(lists)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(int64(position), err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, merged)
+}
+
+// mergeCidrAllowlists returns the normalised CIDR blocks of all the lists, sorted and without duplicates. If a block
+// isn't valid, the position of its list is returned along with the error.
+func mergeCidrAllowlists(lists [][]string) ([]string, int, error) {
+	seen := map[string]bool{}
+	merged := []string{}
+	for position, list := range lists {
+		for _, cidr := range list {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, position, fmt.Errorf("expected %q to be a valid IPv4 or IPv6 CIDR", cidr)
+			}
+			normalised := ipNet.String()
+			if !seen[normalised] {
+				seen[normalised] = true
+				merged = append(merged, normalised)
+			}
+		}
+	}
+	sort.Strings(merged)
+	return merged, 0, nil
+}
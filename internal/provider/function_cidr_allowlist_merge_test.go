@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCidrAllowlistMergeFunction(t *testing.T) {
+	tests := []struct {
+		name     string
+		lists    [][]string
+		expected []string
+		err      *function.FuncError
+	}{
+		{"no lists", nil, []string{}, nil},
+		{"single list", [][]string{{"10.0.0.0/16"}}, []string{"10.0.0.0/16"}, nil},
+		{"sorted and deduplicated", [][]string{{"192.168.0.0/24", "10.0.0.0/16"}, {"10.0.0.0/16"}}, []string{"10.0.0.0/16", "192.168.0.0/24"}, nil},
+		{"normalised", [][]string{{"10.0.0.1/16"}, {"10.0.0.0/16"}}, []string{"10.0.0.0/16"}, nil},
+		{"invalid", [][]string{{"10.0.0.0/16"}, {"10.0.0.0"}}, nil, function.NewArgumentFuncError(1, `expected "10.0.0.0" to be a valid IPv4 or IPv6 CIDR`)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var lists []attr.Value
+			var listTypes []attr.Type
+			for _, list := range test.lists {
+				var elements []attr.Value
+				for _, cidr := range list {
+					elements = append(elements, types.StringValue(cidr))
+				}
+				lists = append(lists, types.ListValueMust(types.StringType, elements))
+				listTypes = append(listTypes, types.ListType{ElemType: types.StringType})
+			}
+
+			// The variadic arguments are passed to the function as a tuple.
+			result, err := runFunction(t, NewCidrAllowlistMergeFunction(), types.TupleValueMust(listTypes, lists))
+			assert.Equal(t, test.err, err)
+			if test.err == nil {
+				expected := []attr.Value{}
+				for _, cidr := range test.expected {
+					expected = append(expected, types.StringValue(cidr))
+				}
+				assert.Equal(t, types.ListValueMust(types.StringType, expected), result)
+			}
+		})
+	}
+}
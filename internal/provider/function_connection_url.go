@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+func NewConnectionUrlFunction() function.Function {
+	return &connectionUrlFunction{}
+}
+
+var _ function.Function = &connectionUrlFunction{}
+
+type connectionUrlFunction struct{}
+
+func (f *connectionUrlFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "connection_url"
+}
+
+func (f *connectionUrlFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the URL to connect to a database",
+		MarkdownDescription: "Builds the URL to connect to a database endpoint, in the same format as the `public_connection_url` and `private_connection_url` attributes of the database resource. An empty string is returned if the endpoint is empty.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "protocol",
+				MarkdownDescription: "The protocol of the database, either `redis` or `memcached`",
+			},
+			function.StringParameter{
+				Name:                "endpoint",
+				MarkdownDescription: "The endpoint of the database, in the format `host:port`",
+			},
+			function.StringParameter{
+				Name:                "password",
+				MarkdownDescription: "The password of the database, which is left out of the URL if empty. Memcached databases never include it",
+			},
+			function.BoolParameter{
+				Name:                "enable_tls",
+				MarkdownDescription: "Whether the database uses TLS, which selects the `rediss` scheme",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *connectionUrlFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var protocol, endpoint, password string
+	var enableTLS bool
+	resp.Error = req.Arguments.Get(ctx, &protocol, &endpoint, &password, &enableTLS)
+	if resp.Error != nil {
+		return
+	}
+
+	if protocol != "redis" && protocol != "memcached" {
+		resp.Error = function.NewArgumentFuncError(0, "expected protocol to be one of [redis memcached], got: "+protocol)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, buildConnectionURL(protocol, endpoint, password, enableTLS))
+}
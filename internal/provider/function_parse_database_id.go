@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var parseDatabaseIdReturnAttrTypes = map[string]attr.Type{
+	"subscription_id": types.StringType,
+	"db_id":           types.Int64Type,
+}
+
+func NewParseDatabaseIdFunction() function.Function {
+	return &parseDatabaseIdFunction{}
+}
+
+var _ function.Function = &parseDatabaseIdFunction{}
+
+type parseDatabaseIdFunction struct{}
+
+func (f *parseDatabaseIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_database_id"
+}
+
+func (f *parseDatabaseIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Split the ID of a database into its subscription ID and database ID",
+		MarkdownDescription: "Splits the ID of a `rediscloud_subscription_database`, in the format `<subscription ID>/<database ID>`, into an object with the `subscription_id` and the `db_id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The ID of the database, in the format `<subscription ID>/<database ID>`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseDatabaseIdReturnAttrTypes,
+		},
+	}
+}
+
+func (f *parseDatabaseIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	// The database resource also accepts a database ID on its own, which doesn't include the subscription.
+	if !strings.Contains(id, "/") {
+		resp.Error = function.NewArgumentFuncError(0, "expected an ID in the format <subscription ID>/<database ID>, got: "+id)
+		return
+	}
+
+	subId, dbId, err := toDatabaseId(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(parseDatabaseIdReturnAttrTypes, map[string]attr.Value{
+		"subscription_id": types.StringValue(strconv.Itoa(subId)),
+		"db_id":           types.Int64Value(int64(dbId)),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, result)
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDatabaseIdFunction(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected attr.Value
+		err      *function.FuncError
+	}{
+		{
+			id: "1234/5678",
+			expected: types.ObjectValueMust(parseDatabaseIdReturnAttrTypes, map[string]attr.Value{
+				"subscription_id": types.StringValue("1234"),
+				"db_id":           types.Int64Value(5678),
+			}),
+		},
+		{
+			id:  "5678",
+			err: function.NewArgumentFuncError(0, "expected an ID in the format <subscription ID>/<database ID>, got: 5678"),
+		},
+		{
+			id:  "1234/5678/9",
+			err: function.NewArgumentFuncError(0, "invalid id: 1234/5678/9"),
+		},
+		{
+			id:  "1234/abc",
+			err: function.NewArgumentFuncError(0, `strconv.Atoi: parsing "abc": invalid syntax`),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.id, func(t *testing.T) {
+			result, err := runFunction(t, NewParseDatabaseIdFunction(), types.StringValue(test.id))
+			assert.Equal(t, test.err, err)
+			if test.err == nil {
+				assert.Equal(t, test.expected, result)
+			}
+		})
+	}
+}
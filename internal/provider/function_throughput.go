@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The shards_to_ops and ops_to_shards functions convert between the two throughput measurements with the same ratios
+// as the API, which depend on the replication and on whether the RedisGraph module is enabled.
+
+func NewShardsToOpsFunction() function.Function {
+	return &throughputFunction{
+		name:        "shards_to_ops",
+		summary:     "Convert a number of shards to operations per second",
+		description: "Converts a throughput measured in `number-of-shards` to `operations-per-second`, with the same ratio as the API.",
+		valueName:   "shards",
+		convert: func(shards int64, opsPerShard int64) int64 {
+			return shards * opsPerShard
+		},
+	}
+}
+
+func NewOpsToShardsFunction() function.Function {
+	return &throughputFunction{
+		name:        "ops_to_shards",
+		summary:     "Convert operations per second to a number of shards",
+		description: "Converts a throughput measured in `operations-per-second` to `number-of-shards`, with the same ratio as the API. The number of shards is rounded down, as when the provider converts the throughput of a database.",
+		valueName:   "ops",
+		convert: func(ops int64, opsPerShard int64) int64 {
+			return int64(throughputShards(int(ops), int(opsPerShard)))
+		},
+	}
+}
+
+var _ function.Function = &throughputFunction{}
+
+type throughputFunction struct {
+	name        string
+	summary     string
+	description string
+	valueName   string
+	convert     func(value int64, opsPerShard int64) int64
+}
+
+func (f *throughputFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *throughputFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             f.summary,
+		MarkdownDescription: f.description,
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                f.valueName,
+				MarkdownDescription: "The throughput value to convert",
+			},
+			function.BoolParameter{
+				Name:                "replication",
+				MarkdownDescription: "Whether the database is replicated",
+			},
+			function.ListParameter{
+				Name:                "modules",
+				MarkdownDescription: "The names of the modules enabled on the database",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *throughputFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value int64
+	var replication bool
+	var modules []string
+	resp.Error = req.Arguments.Get(ctx, &value, &replication, &modules)
+	if resp.Error != nil {
+		return
+	}
+
+	if value < 0 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("expected %s to be at least (0), got %d", f.valueName, value))
+		return
+	}
+
+	graph := false
+	for _, module := range modules {
+		if module == "RedisGraph" {
+			graph = true
+		}
+	}
+
+	resp.Error = resp.Result.Set(ctx, f.convert(value, int64(throughputOpsPerShard(replication, graph))))
+}
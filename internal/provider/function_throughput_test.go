@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThroughputFunctions(t *testing.T) {
+	tests := []struct {
+		name        string
+		function    function.Function
+		value       int64
+		replication bool
+		modules     []string
+		expected    int64
+		err         *function.FuncError
+	}{
+		{"shards without replication", NewShardsToOpsFunction(), 2, false, nil, 2000, nil},
+		{"shards with replication", NewShardsToOpsFunction(), 2, true, []string{"RediSearch"}, 1000, nil},
+		{"shards with graph", NewShardsToOpsFunction(), 2, false, []string{"RedisGraph"}, 500, nil},
+		{"ops without replication", NewOpsToShardsFunction(), 2000, false, nil, 2, nil},
+		{"ops rounded down", NewOpsToShardsFunction(), 2999, false, nil, 2, nil},
+		{"ops with graph rounded down", NewOpsToShardsFunction(), 499, false, []string{"RedisGraph"}, 1, nil},
+		{"ops with graph and replication", NewOpsToShardsFunction(), 1000, true, []string{"RedisGraph"}, 2, nil},
+		{"negative ops", NewOpsToShardsFunction(), -1, false, nil, 0, function.NewArgumentFuncError(0, "expected ops to be at least (0), got -1")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var modules []attr.Value
+			for _, module := range test.modules {
+				modules = append(modules, types.StringValue(module))
+			}
+
+			result, err := runFunction(t, test.function,
+				types.Int64Value(test.value),
+				types.BoolValue(test.replication),
+				types.ListValueMust(types.StringType, modules),
+			)
+			assert.Equal(t, test.err, err)
+			if test.err == nil {
+				assert.Equal(t, types.Int64Value(test.expected), result)
+			}
+		})
+	}
+}
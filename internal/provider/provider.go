@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	rediscloud_api "github.com/RedisLabs/rediscloud-go-api"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
 )
 
 const RedisCloudUrlEnvVar = "REDISCLOUD_URL"
@@ -39,16 +41,26 @@ func New(version string) func() *schema.Provider {
 					Description: fmt.Sprintf("This is the Redis Cloud API secret key. It must be provided but can also be set by the `%s` environment variable.", rediscloud_api.SecretKeyEnvVar),
 					Optional:    true,
 				},
+				"hash_secrets": {
+					Type:        schema.TypeBool,
+					Description: "Whether to store only a salted hash of database passwords and cloud account credentials in the state, instead of the plaintext. Can be overridden by the `hash_secrets` attribute of each resource.",
+					Optional:    true,
+					Default:     false,
+				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
 				"rediscloud_cloud_account":         dataSourceRedisCloudCloudAccount(),
+				"rediscloud_cloud_accounts":        dataSourceRedisCloudCloudAccounts(),
 				"rediscloud_data_persistence":      dataSourceRedisCloudDataPersistence(),
 				"rediscloud_database":              dataSourceRedisCloudDatabase(),
 				"rediscloud_database_modules":      dataSourceRedisCloudDatabaseModules(),
+				"rediscloud_databases":             dataSourceRedisCloudDatabases(),
 				"rediscloud_payment_method":        dataSourceRedisCloudPaymentMethod(),
+				"rediscloud_payment_methods":       dataSourceRedisCloudPaymentMethods(),
 				"rediscloud_regions":               dataSourceRedisCloudRegions(),
 				"rediscloud_subscription":          dataSourceRedisCloudSubscription(),
 				"rediscloud_subscription_peerings": dataSourceRedisCloudSubscriptionPeerings(),
+				"rediscloud_subscriptions":         dataSourceRedisCloudSubscriptions(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"rediscloud_cloud_account":         resourceRedisCloudCloudAccount(),
@@ -69,40 +81,95 @@ var subscriptionMutex = NewPerIdLock()
 
 type apiClient struct {
 	client *rediscloud_api.Client
-}
 
-func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	return func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		var config []rediscloud_api.Option
-		config = append(config, rediscloud_api.AdditionalUserAgent(p.UserAgent("terraform-provider-rediscloud", version)))
+	// Default for the hash_secrets attribute of resources.
+	hashSecrets bool
 
-		url := d.Get("url").(string)
-		apiKey := d.Get("api_key").(string)
-		secretKey := d.Get("secret_key").(string)
+	// Catalog of values supported by the account, which is only retrieved once per provider instance. Errors aren't
+	// cached, so that a transient failure is retried by the next validation.
+	dataPersistenceLock sync.Mutex
+	dataPersistence     []*account.DataPersistence
 
-		if url != "" {
-			config = append(config, rediscloud_api.BaseURL(url))
-		}
+	databaseModulesLock sync.Mutex
+	databaseModules     []*account.DatabaseModule
+}
 
-		if apiKey != "" && secretKey != "" {
-			config = append(config, rediscloud_api.Auth(apiKey, secretKey))
-		}
+// listDataPersistence returns the data persistence options supported by the account, calling the API until it has
+// succeeded once.
+func (a *apiClient) listDataPersistence(ctx context.Context) ([]*account.DataPersistence, error) {
+	a.dataPersistenceLock.Lock()
+	defer a.dataPersistenceLock.Unlock()
 
-		if logging.IsDebugOrHigher() {
-			config = append(config, rediscloud_api.LogRequests(true))
+	if a.dataPersistence == nil {
+		dataPersistence, err := a.client.Account.ListDataPersistence(ctx)
+		if err != nil {
+			return nil, err
 		}
+		a.dataPersistence = dataPersistence
+	}
+	return a.dataPersistence, nil
+}
 
-		config = append(config, rediscloud_api.Logger(&debugLogger{}))
+// listDatabaseModules returns the database modules supported by the account, calling the API until it has succeeded
+// once.
+func (a *apiClient) listDatabaseModules(ctx context.Context) ([]*account.DatabaseModule, error) {
+	a.databaseModulesLock.Lock()
+	defer a.databaseModulesLock.Unlock()
 
-		client, err := rediscloud_api.NewClient(config...)
+	if a.databaseModules == nil {
+		databaseModules, err := a.client.Account.ListDatabaseModules(ctx)
+		if err != nil {
+			return nil, err
+		}
+		a.databaseModules = databaseModules
+	}
+	return a.databaseModules, nil
+}
+
+func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return func(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		client, err := newApiClient(
+			p.UserAgent("terraform-provider-rediscloud", version),
+			d.Get("url").(string),
+			d.Get("api_key").(string),
+			d.Get("secret_key").(string),
+			d.Get("hash_secrets").(bool),
+		)
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
+		return client, nil
+	}
+}
+
+// newApiClient builds the client shared by the SDK and framework providers from the provider configuration.
+func newApiClient(userAgent string, url string, apiKey string, secretKey string, hashSecrets bool) (*apiClient, error) {
+	var config []rediscloud_api.Option
+	config = append(config, rediscloud_api.AdditionalUserAgent(userAgent))
+
+	if url != "" {
+		config = append(config, rediscloud_api.BaseURL(url))
+	}
+
+	if apiKey != "" && secretKey != "" {
+		config = append(config, rediscloud_api.Auth(apiKey, secretKey))
+	}
 
-		return &apiClient{
-			client: client,
-		}, nil
+	if logging.IsDebugOrHigher() {
+		config = append(config, rediscloud_api.LogRequests(true))
 	}
+
+	config = append(config, rediscloud_api.Logger(&debugLogger{}))
+
+	client, err := rediscloud_api.NewClient(config...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiClient{
+		client:      client,
+		hashSecrets: hashSecrets,
+	}, nil
 }
 
 type debugLogger struct{}
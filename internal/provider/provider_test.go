@@ -1,16 +1,47 @@
 package provider
 
 import (
+	"context"
 	rediscloud_api "github.com/RedisLabs/rediscloud-go-api"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/RedisLabs/rediscloud-go-api/redis"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testProvider *schema.Provider
 var providerFactories map[string]func() (*schema.Provider, error)
 
+// muxProviderFactories serves both the SDK and the framework providers, like main.go, for the tests of the resources
+// and functions built on the framework.
+var muxProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"rediscloud": func() (tfprotov5.ProviderServer, error) {
+		return newMuxProviderServer(context.Background())
+	},
+}
+
+func newMuxProviderServer(ctx context.Context) (tfprotov5.ProviderServer, error) {
+	muxServer, err := tf5muxserver.NewMuxServer(ctx,
+		New("dev")().GRPCProvider,
+		providerserver.NewProtocol5(NewFramework("dev")()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer(), nil
+}
+
 func init() {
 	testProvider = New("dev")()
 	providerFactories = map[string]func() (*schema.Provider, error){
@@ -26,6 +57,34 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestMuxServerProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	server, err := newMuxProviderServer(ctx)
+	require.NoError(t, err)
+
+	// The mux server reports an error if the SDK and framework provider schemas differ.
+	resp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Diagnostics)
+	assert.Contains(t, resp.ResourceSchemas, "rediscloud_subscription_database")
+	assert.Contains(t, resp.EphemeralResourceSchemas, "rediscloud_database_credentials")
+	assert.Len(t, resp.Functions, 5)
+}
+
+// runFunction calls a provider function without Terraform, and returns its result.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	ctx := context.Background()
+
+	definition := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definition)
+	result, funcErr := definition.Definition.Return.NewResultData(ctx)
+	require.Nil(t, funcErr)
+
+	resp := function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
 func testAccPreCheck(t *testing.T) {
 	requireEnvironmentVariables(t, RedisCloudUrlEnvVar, rediscloud_api.AccessKeyEnvVar, rediscloud_api.SecretKeyEnvVar)
 }
@@ -49,3 +108,61 @@ func requireEnvironmentVariables(t *testing.T, names ...string) {
 		}
 	}
 }
+
+// testApiClient returns a client for an API served by the handler, for tests which don't call the real API.
+func testApiClient(t *testing.T, handler http.HandlerFunc) *apiClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	api, err := newApiClient("test", server.URL, "key", "secret", false)
+	require.NoError(t, err)
+	return api
+}
+
+// Checks that a failure to list the data persistence options isn't cached, unlike the options themselves.
+func TestListDataPersistenceRetriesErrors(t *testing.T) {
+	calls := 0
+	api := testApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"dataPersistence": [{"name": "none"}]}`))
+	})
+
+	_, err := api.listDataPersistence(context.Background())
+	assert.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		dataPersistence, err := api.listDataPersistence(context.Background())
+		require.NoError(t, err)
+		require.Len(t, dataPersistence, 1)
+		assert.Equal(t, "none", redis.StringValue(dataPersistence[0].Name))
+	}
+	assert.Equal(t, 2, calls)
+}
+
+// Checks that a failure to list the database modules isn't cached, unlike the modules themselves.
+func TestListDatabaseModulesRetriesErrors(t *testing.T) {
+	calls := 0
+	api := testApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"modules": [{"name": "RedisJSON"}]}`))
+	})
+
+	_, err := api.listDatabaseModules(context.Background())
+	assert.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		modules, err := api.listDatabaseModules(context.Background())
+		require.NoError(t, err)
+		require.Len(t, modules, 1)
+		assert.Equal(t, "RedisJSON", redis.StringValue(modules[0].Name))
+	}
+	assert.Equal(t, 2, calls)
+}
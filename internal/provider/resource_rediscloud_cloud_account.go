@@ -20,6 +20,7 @@ func resourceRedisCloudCloudAccount() *schema.Resource {
 		ReadContext:   resourceRedisCloudCloudAccountRead,
 		UpdateContext: resourceRedisCloudCloudAccountUpdate,
 		DeleteContext: resourceRedisCloudCloudAccountDelete,
+		CustomizeDiff: customizeDiffHashSecrets,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -49,12 +50,16 @@ func resourceRedisCloudCloudAccount() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				// Only a hash of the key is stored in the state when hash_secrets is enabled.
+				DiffSuppressFunc: suppressHashedSecretDiff,
 			},
 			"console_password": {
 				Description: "Cloud provider management console password",
 				Type:        schema.TypeString,
 				Required:    true,
 				Sensitive:   true,
+				// Only a hash of the password is stored in the state when hash_secrets is enabled.
+				DiffSuppressFunc: suppressHashedSecretDiff,
 			},
 			"console_username": {
 				Description: "Cloud provider management console username",
@@ -83,6 +88,12 @@ func resourceRedisCloudCloudAccount() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"hash_secrets": {
+				Description: "Whether to store only a salted hash of the secret key and console password in the state. Defaults to the provider's `hash_secrets` setting",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -91,8 +102,8 @@ func resourceRedisCloudCloudAccountCreate(ctx context.Context, d *schema.Resourc
 	client := meta.(*apiClient)
 
 	accessKey := d.Get("access_key_id").(string)
-	secretKey := d.Get("access_secret_key").(string)
-	consolePassword := d.Get("console_password").(string)
+	secretKey := secretValue(d, "access_secret_key")
+	consolePassword := secretValue(d, "console_password")
 	consoleUsername := d.Get("console_username").(string)
 	name := d.Get("name").(string)
 	provider := d.Get("provider_type").(string)
@@ -179,6 +190,27 @@ func resourceRedisCloudCloudAccountRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	// The API doesn't return the secrets, so the values from the configuration are hashed instead.
+	hashSecrets := d.Get("hash_secrets").(bool)
+	if hashSecrets {
+		for _, key := range []string{"access_secret_key", "console_password"} {
+			value := d.Get(key).(string)
+			if value == "" || isSecretHash(value) {
+				continue
+			}
+			hash, err := hashSecret(value, "")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := d.Set(key, hash); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if err := d.Set("hash_secrets", hashSecrets); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return diags
 }
 
@@ -191,8 +223,8 @@ func resourceRedisCloudCloudAccountUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	accessKey := d.Get("access_key_id").(string)
-	secretKey := d.Get("access_secret_key").(string)
-	consolePassword := d.Get("console_password").(string)
+	secretKey := secretValue(d, "access_secret_key")
+	consolePassword := secretValue(d, "console_password")
 	consoleUsername := d.Get("console_username").(string)
 	name := d.Get("name").(string)
 	signInLoginUrl := d.Get("sign_in_login_url").(string)
@@ -653,22 +653,14 @@ func createDatabase(dbName string, idx *int, modules []*subscriptions.CreateModu
 		if search {
 			if *createThroughput.By == "operations-per-second" {
 				createThroughput.By = redis.String("number-of-shards")
-				if replication {
-					createThroughput.Value = redis.Int(*createThroughput.Value / 500)
-				} else {
-					createThroughput.Value = redis.Int(*createThroughput.Value / 1000)
-				}
+				createThroughput.Value = redis.Int(throughputShards(*createThroughput.Value, throughputOpsPerShard(replication, false)))
 			}
 		}
 		// if RedisGraph is in the modules, set throughput to operations-per-second and convert the value
 		if *modules[0].Name == "RedisGraph" {
 			if *createThroughput.By == "number-of-shards" {
 				createThroughput.By = redis.String("operations-per-second")
-				if replication {
-					createThroughput.Value = redis.Int(*createThroughput.Value * 500)
-				} else {
-					createThroughput.Value = redis.Int(*createThroughput.Value * 250)
-				}
+				createThroughput.Value = redis.Int(*createThroughput.Value * throughputOpsPerShard(replication, true))
 			}
 		}
 	}
@@ -693,6 +685,27 @@ func createDatabase(dbName string, idx *int, modules []*subscriptions.CreateModu
 	return databases
 }
 
+// throughputOpsPerShard returns the operations per second that a single shard is allotted when converting between the
+// 'number-of-shards' and 'operations-per-second' throughput measurements.
+func throughputOpsPerShard(replication bool, graph bool) int {
+	if graph {
+		if replication {
+			return 500
+		}
+		return 250
+	}
+	if replication {
+		return 500
+	}
+	return 1000
+}
+
+// throughputShards converts operations per second to a number of shards, rounding down as the API does when it
+// converts the throughput of a database with modules.
+func throughputShards(ops int, opsPerShard int) int {
+	return ops / opsPerShard
+}
+
 func waitForSubscriptionToBeActive(ctx context.Context, id int, api *apiClient) error {
 	wait := &resource.StateChangeConf{
 		Delay:   10 * time.Second,
@@ -2,18 +2,32 @@ package provider
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/RedisLabs/rediscloud-go-api/redis"
+	"github.com/RedisLabs/rediscloud-go-api/service/account"
 	"github.com/RedisLabs/rediscloud-go-api/service/databases"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// How long before a client certificate expires that a warning is shown when planning.
+const clientSSLCertificateExpiryWarning = 30 * 24 * time.Hour
+
 func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Creates database resource within a subscription in your Redis Enterprise Cloud Account.",
@@ -21,6 +35,13 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 		ReadContext:   resourceRedisCloudSubscriptionDatabaseRead,
 		UpdateContext: resourceRedisCloudSubscriptionDatabaseUpdate,
 		DeleteContext: resourceRedisCloudSubscriptionDatabaseDelete,
+		CustomizeDiff: customdiff.All(
+			customizeDiffDatabaseDataPersistence,
+			customizeDiffDatabaseModules,
+			customizeDiffDatabaseMemcachedPassword,
+			customizeDiffDatabaseName,
+			customizeDiffHashSecrets,
+		),
 
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -28,7 +49,7 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 				if err != nil {
 					return nil, err
 				}
-				if err := d.Set("subscription_id", subId); err != nil {
+				if err := d.Set("subscription_id", strconv.Itoa(subId)); err != nil {
 					return nil, err
 				}
 				if err := d.Set("db_id", dbId); err != nil {
@@ -39,6 +60,16 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 			},
 		},
 
+		// Version 1 changed subscription_id from a number to a string, like the other resources and data sources.
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceRedisCloudSubscriptionDatabaseV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceRedisCloudSubscriptionDatabaseStateUpgradeV0,
+			},
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
@@ -48,10 +79,11 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
-				Description: "Identifier of the subscription",
-				Type:        schema.TypeInt,
-				Required:    true,
-				ForceNew:    true,
+				Description:      "Identifier of the subscription",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateDiagFunc(validation.StringMatch(regexp.MustCompile("^\\d+$"), "must be a number")),
 			},
 			"db_id": {
 				Description: "Identifier of the database created",
@@ -95,10 +127,11 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 				Default:     "none",
 			},
 			"data_eviction": {
-				Description: "(Optional) The data items eviction policy (either: 'allkeys-lru', 'allkeys-lfu', 'allkeys-random', 'volatile-lru', 'volatile-lfu', 'volatile-random', 'volatile-ttl' or 'noeviction'. Default: 'volatile-lru')",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "volatile-lru",
+				Description:      "(Optional) The data items eviction policy (either: 'allkeys-lru', 'allkeys-lfu', 'allkeys-random', 'volatile-lru', 'volatile-lfu', 'volatile-random', 'volatile-ttl' or 'noeviction'. Default: 'volatile-lru')",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "volatile-lru",
+				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice(databases.DataEvictionPolicyValues(), false)),
 			},
 			"replication": {
 				Description: "Databases replication",
@@ -111,11 +144,13 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: validateDiagFunc(validation.StringInSlice([]string{"number-of-shards", "operations-per-second"}, false)),
+				DiffSuppressFunc: suppressEquivalentThroughput,
 			},
 			"throughput_measurement_value": {
-				Description: "Throughput value (as applies to selected measurement method)",
-				Type:        schema.TypeInt,
-				Required:    true,
+				Description:      "Throughput value (as applies to selected measurement method)",
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentThroughput,
 			},
 			"average_item_size_in_bytes": {
 				Description: "Relevant only to ram-and-flash clusters. Estimated average size (measured in bytes) of the items stored in the database",
@@ -131,6 +166,48 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 				Optional:    true,
 				Sensitive:   true,
 				Computed:    true,
+				// Only a hash of the password is stored in the state when hash_secrets is enabled.
+				DiffSuppressFunc: suppressHashedSecretDiff,
+				ConflictsWith:    []string{"password_wo"},
+			},
+			"password_wo": {
+				Description:  "Password used to access the database, which is never stored in the plan or the state. It is only sent when the database is created or password_wo_version changes",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				RequiredWith: []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Description:      "Version of password_wo, to be changed in order to update the password",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				RequiredWith:     []string{"password_wo"},
+				ValidateDiagFunc: validateDiagFunc(validation.IntAtLeast(1)),
+			},
+			"hash_secrets": {
+				Description: "Whether to store only a salted hash of the password in the state. Defaults to the provider's `hash_secrets` setting",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"expose_password": {
+				Description: "Whether to set `password_plaintext`, and to include the password in the connection attributes when hash_secrets is enabled",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"password_plaintext": {
+				Description: "Password used to access the database, only set when expose_password is enabled",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"adopt_existing": {
+				Description: "Whether to take over a database with the same name in the subscription, instead of creating a new one",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
 			},
 			"public_endpoint": {
 				Description: "Public endpoint to access the database",
@@ -142,11 +219,40 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
-			"client_ssl_certificate": {
-				Description: "SSL certificate to authenticate user connections",
+			"shard_count": {
+				Description: "Number of shards the database is deployed on",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"effective_throughput_ops": {
+				Description: "Throughput of the database in operations per second, after any conversion made by the API",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"public_connection_url": {
+				Description: "URL, including the credentials, to connect to the database through its public endpoint",
 				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"private_connection_url": {
+				Description: "URL, including the credentials, to connect to the database through its private endpoint",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"connection_json": {
+				Description: "JSON document describing how to connect to the database, including the credentials",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"client_ssl_certificate": {
+				Description:      "SSL certificate to authenticate user connections",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				ValidateDiagFunc: validateClientSSLCertificate,
 			},
 			"periodic_backup_path": {
 				Description: "Path that will be used to store database backup files",
@@ -237,16 +343,37 @@ func resourceRedisCloudSubscriptionDatabase() *schema.Resource {
 func resourceRedisCloudSubscriptionDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*apiClient)
 
-	subId := d.Get("subscription_id").(int)
+	subId, err := strconv.Atoi(d.Get("subscription_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	password, err := databasePassword(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	subscriptionMutex.Lock(subId)
 
+	if d.Get("adopt_existing").(bool) {
+		adopted, err := adoptDatabase(ctx, d, subId, api)
+		if err != nil {
+			subscriptionMutex.Unlock(subId)
+			return diag.FromErr(err)
+		}
+		if adopted {
+			// The adopted database has no previous state to compare the configuration with, so all of it is applied.
+			subscriptionMutex.Unlock(subId)
+			return updateDatabase(ctx, d, meta, true)
+		}
+	}
+
 	name := d.Get("name").(string)
 	protocol := d.Get("protocol").(string)
 	memoryLimitInGB := d.Get("memory_limit_in_gb").(float64)
 	supportOSSClusterAPI := d.Get("support_oss_cluster_api").(bool)
 	dataPersistence := d.Get("data_persistence").(string)
 	dataEviction := d.Get("data_eviction").(string)
-	password := d.Get("password").(string)
 	replication := d.Get("replication").(bool)
 	throughputMeasurementBy := d.Get("throughput_measurement_by").(string)
 	throughputMeasurementValue := d.Get("throughput_measurement_value").(int)
@@ -326,6 +453,59 @@ func resourceRedisCloudSubscriptionDatabaseCreate(ctx context.Context, d *schema
 	return resourceRedisCloudSubscriptionDatabaseUpdate(ctx, d, meta)
 }
 
+// adoptDatabase takes over the database in the subscription with the configured name, if there is one. The caller must
+// hold the subscription's lock.
+func adoptDatabase(ctx context.Context, d *schema.ResourceData, subId int, api *apiClient) (bool, error) {
+	dbIds, err := getDatabaseNameIdMap(ctx, subId, api)
+	if err != nil {
+		return false, err
+	}
+	dbId, ok := dbIds[d.Get("name").(string)]
+	if !ok {
+		return false, nil
+	}
+
+	db, err := api.client.Database.Get(ctx, subId, dbId)
+	if err != nil {
+		return false, err
+	}
+	if err := validateAdoptedDatabase(d, db); err != nil {
+		return false, err
+	}
+
+	d.SetId(buildResourceId(subId, dbId))
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// validateAdoptedDatabase rejects a database whose attributes which can't be updated differ from the configuration, as
+// the next plan would otherwise replace the database which is being recovered.
+func validateAdoptedDatabase(d *schema.ResourceData, db *databases.Database) error {
+	if protocol := redis.StringValue(db.Protocol); protocol != d.Get("protocol").(string) {
+		return fmt.Errorf("database %d can't be adopted as its protocol is %q, not %q", redis.IntValue(db.ID), protocol, d.Get("protocol").(string))
+	}
+
+	var expected []string
+	for _, module := range d.Get("modules").(*schema.Set).List() {
+		expected = append(expected, module.(map[string]interface{})["name"].(string))
+	}
+	var actual []string
+	for _, module := range db.Modules {
+		actual = append(actual, redis.StringValue(module.Name))
+	}
+	sort.Strings(expected)
+	sort.Strings(actual)
+	if strings.Join(expected, ",") != strings.Join(actual, ",") {
+		return fmt.Errorf("database %d can't be adopted as its modules are [%s], not [%s]", redis.IntValue(db.ID), strings.Join(actual, ", "), strings.Join(expected, ", "))
+	}
+
+	return nil
+}
+
 func resourceRedisCloudSubscriptionDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	api := meta.(*apiClient)
 
@@ -338,7 +518,10 @@ func resourceRedisCloudSubscriptionDatabaseRead(ctx context.Context, d *schema.R
 
 	// We are not import this resource, so we can read the subscription_id defined in this resource.
 	if subId == 0 {
-		subId = d.Get("subscription_id").(int)
+		subId, err = strconv.Atoi(d.Get("subscription_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	db, err := api.client.Database.Get(ctx, subId, dbId)
@@ -390,6 +573,10 @@ func resourceRedisCloudSubscriptionDatabaseRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	if err := setClusterTopology(d, db); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err := d.Set("public_endpoint", redis.StringValue(db.PublicEndpoint)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -406,39 +593,104 @@ func resourceRedisCloudSubscriptionDatabaseRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	// The API doesn't return the average item size or the periodic backup path, so the values in the state are kept.
 	if err := d.Set("average_item_size_in_bytes", d.Get("average_item_size_in_bytes").(int)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("external_endpoint_for_oss_cluster_api",
-		d.Get("external_endpoint_for_oss_cluster_api").(bool)); err != nil {
+	if err := d.Set("periodic_backup_path", d.Get("periodic_backup_path").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only used when creating the resource, so it isn't something which can be read.
+	if err := d.Set("adopt_existing", d.Get("adopt_existing").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The external endpoint setting isn't returned either, but it can only be enabled alongside the OSS Cluster API.
+	externalEndpointForOSSClusterAPI := false
+	if redis.BoolValue(db.SupportOSSClusterAPI) {
+		externalEndpointForOSSClusterAPI = d.Get("external_endpoint_for_oss_cluster_api").(bool)
+	}
+	if err := d.Set("external_endpoint_for_oss_cluster_api", externalEndpointForOSSClusterAPI); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var replicaOf []string
+	if db.ReplicaOf != nil {
+		replicaOf = redis.StringSliceValue(db.ReplicaOf.Endpoints...)
+	}
+	if err := d.Set("replica_of", replicaOf); err != nil {
 		return diag.FromErr(err)
 	}
 
-	password := d.Get("password").(string)
+	// Only db with the "redis" protocol returns the password, memcached databases don't have one.
+	password := ""
 	if redis.StringValue(db.Protocol) == "redis" {
-		// Only db with the "redis" protocol returns the password.
 		password = redis.StringValue(db.Security.Password)
 	}
 
-	if err := d.Set("password", password); err != nil {
+	// The plaintext is only copied to password_plaintext, and kept in the connection attributes when hashing secrets or
+	// using a write-only password, if it has been explicitly requested. A write-only password isn't stored at all.
+	hashSecrets := d.Get("hash_secrets").(bool)
+	exposePassword := d.Get("expose_password").(bool)
+	writeOnlyPassword := d.Get("password_wo_version").(int) != 0
+	statePassword := password
+	if writeOnlyPassword {
+		statePassword = ""
+	} else if hashSecrets && password != "" {
+		statePassword, err = hashSecret(password, d.Get("password").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	plaintextPassword := ""
+	if exposePassword {
+		plaintextPassword = password
+	}
+	connectionPassword := password
+	if (hashSecrets || writeOnlyPassword) && !exposePassword {
+		connectionPassword = ""
+	}
+
+	if err := d.Set("password", statePassword); err != nil {
 		return diag.FromErr(err)
 	}
-	var sourceIPs []string
-	if !(len(db.Security.SourceIPs) == 1 && redis.StringValue(db.Security.SourceIPs[0]) == "0.0.0.0/0") {
-		// The API handles an empty list as ["0.0.0.0/0"] but need to be careful to match the input to avoid Terraform detecting drift
-		sourceIPs = redis.StringSliceValue(db.Security.SourceIPs...)
+	if err := d.Set("password_plaintext", plaintextPassword); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hash_secrets", hashSecrets); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expose_password", d.Get("expose_password").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source_ips", flattenSourceIPs(db.Security.SourceIPs)); err != nil {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("source_ips", sourceIPs); err != nil {
+	var regexRules []*databases.RegexRule
+	if db.Clustering != nil {
+		regexRules = db.Clustering.RegexRules
+	}
+	if err := d.Set("hashing_policy", flattenRegexRules(regexRules)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("hashing_policy", flattenRegexRules(db.Clustering.RegexRules)); err != nil {
+	if err := d.Set("enable_tls", redis.BoolValue(db.Security.EnableTls)); err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("enable_tls", redis.Bool(*db.Security.EnableTls)); err != nil {
+	if err := setConnectionDetails(d, db, connectionPassword); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API only reports whether client authentication is enabled, not the certificate itself.
+	clientSSLCertificate := ""
+	if redis.BoolValue(db.Security.SSLClientAuthentication) {
+		clientSSLCertificate = d.Get("client_ssl_certificate").(string)
+	}
+	if err := d.Set("client_ssl_certificate", clientSSLCertificate); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -450,9 +702,8 @@ func resourceRedisCloudSubscriptionDatabaseDelete(ctx context.Context, d *schema
 	api := meta.(*apiClient)
 
 	var diags diag.Diagnostics
-	subId := d.Get("subscription_id").(int)
 
-	_, dbId, err := toDatabaseId(d.Id())
+	subId, dbId, err := toDatabaseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -472,100 +723,644 @@ func resourceRedisCloudSubscriptionDatabaseDelete(ctx context.Context, d *schema
 }
 
 func resourceRedisCloudSubscriptionDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return updateDatabase(ctx, d, meta, false)
+}
+
+// updateDatabase sends the attributes which have changed to the API, or all of the configured attributes when all is
+// set.
+func updateDatabase(ctx context.Context, d *schema.ResourceData, meta interface{}, all bool) diag.Diagnostics {
 	api := meta.(*apiClient)
 
-	_, dbId, err := toDatabaseId(d.Id())
+	subId, dbId, err := toDatabaseId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	subId := d.Get("subscription_id").(int)
+	if !all && !d.HasChanges(databaseUpdatableAttributes...) {
+		return resourceRedisCloudSubscriptionDatabaseRead(ctx, d, meta)
+	}
+
 	subscriptionMutex.Lock(subId)
 	defer subscriptionMutex.Unlock(subId)
 
-	var alerts []*databases.UpdateAlert
-	for _, alert := range d.Get("alert").(*schema.Set).List() {
-		dbAlert := alert.(map[string]interface{})
+	update, err := buildUpdateDatabase(d, all)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		alerts = append(alerts, &databases.UpdateAlert{
-			Name:  redis.String(dbAlert["name"].(string)),
-			Value: redis.Int(dbAlert["value"].(int)),
-		})
+	err = api.client.Database.Update(ctx, subId, dbId, update)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
+		return diag.FromErr(err)
 	}
 
-	update := databases.UpdateDatabase{
-		Name:                 redis.String(d.Get("name").(string)),
-		MemoryLimitInGB:      redis.Float64(d.Get("memory_limit_in_gb").(float64)),
-		SupportOSSClusterAPI: redis.Bool(d.Get("support_oss_cluster_api").(bool)),
-		Replication:          redis.Bool(d.Get("replication").(bool)),
-		ThroughputMeasurement: &databases.UpdateThroughputMeasurement{
+	if err := waitForSubscriptionToBeActive(ctx, subId, api); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceRedisCloudSubscriptionDatabaseRead(ctx, d, meta)
+}
+
+// The attributes which can be changed without recreating the database.
+var databaseUpdatableAttributes = []string{
+	"name",
+	"memory_limit_in_gb",
+	"support_oss_cluster_api",
+	"external_endpoint_for_oss_cluster_api",
+	"replication",
+	"throughput_measurement_by",
+	"throughput_measurement_value",
+	"data_persistence",
+	"data_eviction",
+	"source_ips",
+	"alert",
+	"password",
+	"password_wo_version",
+	"replica_of",
+	"enable_tls",
+	"client_ssl_certificate",
+	"hashing_policy",
+	"periodic_backup_path",
+}
+
+// buildUpdateDatabase only includes the attributes which have changed, so that an update doesn't create API tasks
+// for settings which are already in place. When all is set, every attribute is included instead, as HasChange doesn't
+// report the zero values of a resource without previous state.
+func buildUpdateDatabase(d *schema.ResourceData, all bool) (databases.UpdateDatabase, error) {
+	update := databases.UpdateDatabase{}
+	changed := func(keys ...string) bool {
+		return all || d.HasChanges(keys...)
+	}
+
+	if changed("name") {
+		update.Name = redis.String(d.Get("name").(string))
+	}
+
+	if changed("memory_limit_in_gb") {
+		update.MemoryLimitInGB = redis.Float64(d.Get("memory_limit_in_gb").(float64))
+	}
+
+	if changed("support_oss_cluster_api") {
+		update.SupportOSSClusterAPI = redis.Bool(d.Get("support_oss_cluster_api").(bool))
+	}
+
+	if changed("external_endpoint_for_oss_cluster_api") {
+		update.UseExternalEndpointForOSSClusterAPI = redis.Bool(d.Get("external_endpoint_for_oss_cluster_api").(bool))
+	}
+
+	if changed("replication") {
+		update.Replication = redis.Bool(d.Get("replication").(bool))
+	}
+
+	// The measurement method and value are only meaningful together.
+	if changed("throughput_measurement_by", "throughput_measurement_value") {
+		update.ThroughputMeasurement = &databases.UpdateThroughputMeasurement{
 			By:    redis.String(d.Get("throughput_measurement_by").(string)),
 			Value: redis.Int(d.Get("throughput_measurement_value").(int)),
-		},
-		DataPersistence:    redis.String(d.Get("data_persistence").(string)),
-		DataEvictionPolicy: redis.String(d.Get("data_eviction").(string)),
-		SourceIP:           setToStringSlice(d.Get("source_ips").(*schema.Set)),
-		Alerts:             alerts,
+		}
+	}
+
+	if changed("data_persistence") {
+		update.DataPersistence = redis.String(d.Get("data_persistence").(string))
+	}
+
+	if changed("data_eviction") {
+		update.DataEvictionPolicy = redis.String(d.Get("data_eviction").(string))
+	}
+
+	if changed("source_ips") {
+		update.SourceIP = setToStringSlice(d.Get("source_ips").(*schema.Set))
+		if len(update.SourceIP) == 0 {
+			update.SourceIP = []*string{redis.String("0.0.0.0/0")}
+		}
 	}
-	if len(setToStringSlice(d.Get("source_ips").(*schema.Set))) == 0 {
-		update.SourceIP = []*string{redis.String("0.0.0.0/0")}
+
+	if changed("alert") {
+		alerts := make([]*databases.UpdateAlert, 0)
+		for _, alert := range d.Get("alert").(*schema.Set).List() {
+			dbAlert := alert.(map[string]interface{})
+
+			alerts = append(alerts, &databases.UpdateAlert{
+				Name:  redis.String(dbAlert["name"].(string)),
+				Value: redis.Int(dbAlert["value"].(int)),
+			})
+		}
+		update.Alerts = alerts
 	}
 
-	if d.Get("password").(string) != "" {
+	if changed("password") && d.Get("password").(string) != "" {
 		update.Password = redis.String(d.Get("password").(string))
 	}
 
+	// The write-only password isn't in the state, so a change is signalled by its version instead.
+	if changed("password_wo_version") {
+		password, err := databasePassword(d)
+		if err != nil {
+			return update, err
+		}
+		if password != "" {
+			update.Password = redis.String(password)
+		}
+	}
+
+	// The API client always sends replicaOf and a null value would remove the replication, so the current value is
+	// sent even when it hasn't changed.
 	update.ReplicaOf = setToStringSlice(d.Get("replica_of").(*schema.Set))
 	if update.ReplicaOf == nil {
 		update.ReplicaOf = make([]*string, 0)
 	}
 
+	// TLS and the client certificate are sent together, as the combination of both decides how clients authenticate.
 	// The cert validation is done by the API (HTTP 400 is returned if it's invalid).
-	clientSSLCertificate := d.Get("client_ssl_certificate").(string)
-	enableTLS := d.Get("enable_tls").(bool)
-	if enableTLS {
-		// TLS only: enable_tls=true, client_ssl_certificate="".
-		update.EnableTls = redis.Bool(enableTLS)
-		// mTLS: enableTls=true, non-empty client_ssl_certificate.
-		if clientSSLCertificate != "" {
-			update.ClientSSLCertificate = redis.String(clientSSLCertificate)
-		}
-	} else {
-		// mTLS (backward compatibility): enable_tls=false, non-empty client_ssl_certificate.
-		if clientSSLCertificate != "" {
-			update.ClientSSLCertificate = redis.String(clientSSLCertificate)
-		} else {
-			// Default: enable_tls=false, client_ssl_certificate=""
+	if changed("enable_tls", "client_ssl_certificate") {
+		clientSSLCertificate := d.Get("client_ssl_certificate").(string)
+		enableTLS := d.Get("enable_tls").(bool)
+		if enableTLS {
+			// TLS only: enable_tls=true, client_ssl_certificate="".
 			update.EnableTls = redis.Bool(enableTLS)
+			// mTLS: enableTls=true, non-empty client_ssl_certificate.
+			if clientSSLCertificate != "" {
+				update.ClientSSLCertificate = redis.String(clientSSLCertificate)
+			}
+		} else {
+			// mTLS (backward compatibility): enable_tls=false, non-empty client_ssl_certificate.
+			if clientSSLCertificate != "" {
+				update.ClientSSLCertificate = redis.String(clientSSLCertificate)
+			} else {
+				// Default: enable_tls=false, client_ssl_certificate=""
+				update.EnableTls = redis.Bool(enableTLS)
+			}
 		}
 	}
 
-	regex := d.Get("hashing_policy").([]interface{})
-	if len(regex) != 0 {
-		update.RegexRules = interfaceToStringSlice(regex)
+	if changed("hashing_policy") {
+		regex := d.Get("hashing_policy").([]interface{})
+		if len(regex) != 0 {
+			update.RegexRules = interfaceToStringSlice(regex)
+		}
 	}
 
-	backupPath := d.Get("periodic_backup_path").(string)
-	if backupPath != "" {
-		update.PeriodicBackupPath = redis.String(backupPath)
+	if changed("periodic_backup_path") {
+		backupPath := d.Get("periodic_backup_path").(string)
+		if backupPath != "" {
+			update.PeriodicBackupPath = redis.String(backupPath)
+		}
 	}
 
-	update.UseExternalEndpointForOSSClusterAPI = redis.Bool(d.Get("external_endpoint_for_oss_cluster_api").(bool))
+	return update, nil
+}
 
-	err = api.client.Database.Update(ctx, subId, dbId, update)
+// suppressEquivalentThroughput hides the difference between the configured throughput and the one returned by the API
+// when the API has only converted it because of the database's modules, with the ratios used in createDatabase.
+func suppressEquivalentThroughput(_, _, _ string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	oldBy, newBy := d.GetChange("throughput_measurement_by")
+	oldValue, newValue := d.GetChange("throughput_measurement_value")
+
+	var modules []string
+	for _, module := range d.Get("modules").(*schema.Set).List() {
+		modules = append(modules, module.(map[string]interface{})["name"].(string))
+	}
+	convertTo, opsPerShard := throughputConversion(modules, d.Get("replication").(bool))
+
+	return throughputEquivalent(oldBy.(string), oldValue.(int), newBy.(string), newValue.(int), convertTo, opsPerShard)
+}
+
+// throughputConversion returns the throughput measurement that the API converts the configured one to because of the
+// modules, as in createDatabase, along with the ratio used. The measurement is empty when no conversion is made.
+func throughputConversion(modules []string, replication bool) (string, int) {
+	for _, module := range modules {
+		switch module {
+		case "RediSearch":
+			return "number-of-shards", throughputOpsPerShard(replication, false)
+		case "RedisGraph":
+			return "operations-per-second", throughputOpsPerShard(replication, true)
+		}
+	}
+	return "", 0
+}
+
+// throughputEquivalent reports whether the throughput returned by the API (the old value) is what the configured
+// throughput (the new value) would have been converted to, when the API converts it to the convertTo measurement.
+func throughputEquivalent(apiBy string, apiValue int, configBy string, configValue int, convertTo string, opsPerShard int) bool {
+	if apiBy == configBy && apiValue == configValue {
+		return true
+	}
+	if convertTo == "" || apiBy != convertTo {
+		return false
+	}
+
+	switch {
+	case configBy == "operations-per-second" && apiBy == "number-of-shards":
+		return apiValue == throughputShards(configValue, opsPerShard)
+	case configBy == "number-of-shards" && apiBy == "operations-per-second":
+		return apiValue == configValue*opsPerShard
+	case configBy == "operations-per-second" && apiBy == "operations-per-second":
+		// Rounded up to the operations per second of a whole number of shards
+		shards := (configValue + opsPerShard - 1) / opsPerShard
+		return apiValue == shards*opsPerShard
+	}
+
+	return false
+}
+
+// customizeDiffDatabaseDataPersistence checks the data persistence option against the ones supported by the account,
+// so that a typo fails when planning instead of after the database has started being created.
+func customizeDiffDatabaseDataPersistence(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("data_persistence", "protocol") {
+		return nil
+	}
+	if !diff.NewValueKnown("data_persistence") || !diff.NewValueKnown("protocol") {
+		return nil
+	}
+
+	api := meta.(*apiClient)
+	available, err := api.listDataPersistence(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
 	}
 
-	if err := waitForDatabaseToBeActive(ctx, subId, dbId, api); err != nil {
-		return diag.FromErr(err)
+	return validateDataPersistence(diff.Get("protocol").(string), diff.Get("data_persistence").(string), available)
+}
+
+func validateDataPersistence(protocol string, dataPersistence string, available []*account.DataPersistence) error {
+	var names []string
+	supported := false
+	for _, option := range available {
+		name := redis.StringValue(option.Name)
+		names = append(names, name)
+		if name == dataPersistence {
+			supported = true
+		}
+	}
+	if !supported {
+		return fmt.Errorf(`data_persistence %q is not supported, expected one of: %s`, dataPersistence, strings.Join(names, ", "))
 	}
 
-	if err := waitForSubscriptionToBeActive(ctx, subId, api); err != nil {
-		return diag.FromErr(err)
+	if protocol == "memcached" && dataPersistence != "none" {
+		return fmt.Errorf(`data_persistence must be "none" when the protocol is "memcached"`)
 	}
 
-	return resourceRedisCloudSubscriptionDatabaseRead(ctx, d, meta)
+	return nil
+}
+
+// customizeDiffDatabaseModules checks the modules against the ones supported by the account and against each other,
+// which would otherwise only be rejected by the API once the database is being created.
+func customizeDiffDatabaseModules(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("modules", "protocol", "throughput_measurement_by") {
+		return nil
+	}
+	if !diff.NewValueKnown("modules") || !diff.NewValueKnown("protocol") {
+		return nil
+	}
+
+	var modules []string
+	for _, module := range diff.Get("modules").(*schema.Set).List() {
+		modules = append(modules, module.(map[string]interface{})["name"].(string))
+	}
+	if len(modules) == 0 {
+		return nil
+	}
+
+	api := meta.(*apiClient)
+	available, err := api.listDatabaseModules(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := validateDatabaseModules(diff.Get("protocol").(string), modules, available); err != nil {
+		return err
+	}
+
+	if warning := throughputConversionWarning(modules, diff.Get("throughput_measurement_by").(string)); warning != "" {
+		log.Printf("[WARN] %s", warning)
+	}
+
+	return nil
+}
+
+func validateDatabaseModules(protocol string, modules []string, available []*account.DatabaseModule) error {
+	if protocol == "memcached" {
+		return fmt.Errorf(`modules can't be enabled when the protocol is "memcached"`)
+	}
+
+	var names []string
+	supported := map[string]bool{}
+	for _, module := range available {
+		name := redis.StringValue(module.Name)
+		names = append(names, name)
+		supported[name] = true
+	}
+
+	for _, module := range modules {
+		if !supported[module] {
+			return fmt.Errorf("module %q is not supported, expected one of: %s", module, strings.Join(names, ", "))
+		}
+		// The creation plan gives RedisGraph a database of its own for the same reason.
+		if module == "RedisGraph" && len(modules) > 1 {
+			return fmt.Errorf("the RedisGraph module can't be combined with other modules in the same database")
+		}
+	}
+
+	return nil
+}
+
+// throughputConversionWarning returns a message when the API will convert the throughput measurement because of the
+// modules, as is done for the creation plan in createDatabase.
+func throughputConversionWarning(modules []string, throughputMeasurementBy string) string {
+	for _, module := range modules {
+		if module == "RediSearch" && throughputMeasurementBy == "operations-per-second" {
+			return "databases with the RediSearch module measure their throughput by 'number-of-shards', the 'operations-per-second' value will be converted"
+		}
+		if module == "RedisGraph" && throughputMeasurementBy == "number-of-shards" {
+			return "databases with the RedisGraph module measure their throughput by 'operations-per-second', the 'number-of-shards' value will be converted"
+		}
+	}
+	return ""
+}
+
+// customizeDiffDatabaseMemcachedPassword rejects a password on a memcached database, as the API ignores it.
+func customizeDiffDatabaseMemcachedPassword(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Get("protocol").(string) != "memcached" {
+		return nil
+	}
+
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	for _, key := range []string{"password", "password_wo"} {
+		password := config.GetAttr(key)
+		if password.IsKnown() && !password.IsNull() {
+			return fmt.Errorf(`%s can't be set when the protocol is "memcached"`, key)
+		}
+	}
+
+	return nil
+}
+
+// databasePassword returns the password set in the configuration, either in password or in the write-only password_wo
+// which can only be read from the raw configuration.
+func databasePassword(d *schema.ResourceData) (string, error) {
+	if config := d.GetRawConfig(); config.IsNull() || !config.IsKnown() {
+		return d.Get("password").(string), nil
+	}
+
+	password, diags := d.GetRawConfigAt(cty.GetAttrPath("password_wo"))
+	if diags.HasError() {
+		return "", fmt.Errorf("failed to read password_wo: %s", diags[0].Summary)
+	}
+	if password.IsKnown() && !password.IsNull() {
+		return password.AsString(), nil
+	}
+	return d.Get("password").(string), nil
+}
+
+// customizeDiffDatabaseName rejects a name which is already used by another database in the subscription, unless that
+// database is going to be adopted.
+func customizeDiffDatabaseName(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("name") {
+		return nil
+	}
+	if !diff.NewValueKnown("subscription_id") || !diff.NewValueKnown("name") {
+		return nil
+	}
+	if diff.Id() == "" && diff.Get("adopt_existing").(bool) {
+		return nil
+	}
+
+	subId, err := strconv.Atoi(diff.Get("subscription_id").(string))
+	if err != nil {
+		return err
+	}
+	name := diff.Get("name").(string)
+
+	api := meta.(*apiClient)
+	dbIds, err := getDatabaseNameIdMap(ctx, subId, api)
+	if err != nil {
+		return err
+	}
+
+	if dbId, ok := dbIds[name]; ok && buildResourceId(subId, dbId) != diff.Id() {
+		return fmt.Errorf("a database named %q already exists in subscription %d with the ID %d, set adopt_existing to manage it", name, subId, dbId)
+	}
+
+	return nil
+}
+
+func shardCount(db *databases.Database) int {
+	if db.Clustering == nil {
+		return 0
+	}
+	return redis.IntValue(db.Clustering.NumberOfShards)
+}
+
+// setClusterTopology sets the shard count and effective throughput, which are shared by the database resource and
+// data source.
+func setClusterTopology(d *schema.ResourceData, db *databases.Database) error {
+	if err := d.Set("shard_count", shardCount(db)); err != nil {
+		return err
+	}
+
+	return d.Set("effective_throughput_ops", effectiveThroughputOps(db))
+}
+
+// effectiveThroughputOps returns the throughput of the database in operations per second, converting a
+// 'number-of-shards' measurement with the same ratios used when the creation plan is built.
+func effectiveThroughputOps(db *databases.Database) int {
+	if db.ThroughputMeasurement == nil {
+		return 0
+	}
+
+	value := redis.IntValue(db.ThroughputMeasurement.Value)
+	if redis.StringValue(db.ThroughputMeasurement.By) != "number-of-shards" {
+		return value
+	}
+
+	graph := false
+	for _, module := range db.Modules {
+		if redis.StringValue(module.Name) == "RedisGraph" {
+			graph = true
+			break
+		}
+	}
+
+	return value * throughputOpsPerShard(redis.BoolValue(db.Replication), graph)
+}
+
+// setConnectionDetails sets the connection URLs and JSON document, which are shared by the database resource and
+// data source.
+func setConnectionDetails(d *schema.ResourceData, db *databases.Database, password string) error {
+	publicURL, privateURL, connectionJSON, err := buildConnectionDetails(db, password)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("public_connection_url", publicURL); err != nil {
+		return err
+	}
+	if err := d.Set("private_connection_url", privateURL); err != nil {
+		return err
+	}
+	return d.Set("connection_json", connectionJSON)
+}
+
+// buildConnectionDetails returns the public and private connection URLs of the database, along with the JSON document
+// describing them.
+func buildConnectionDetails(db *databases.Database, password string) (string, string, string, error) {
+	protocol := redis.StringValue(db.Protocol)
+	enableTLS := db.Security != nil && redis.BoolValue(db.Security.EnableTls)
+	publicEndpoint := redis.StringValue(db.PublicEndpoint)
+	privateEndpoint := redis.StringValue(db.PrivateEndpoint)
+
+	publicURL := buildConnectionURL(protocol, publicEndpoint, password, enableTLS)
+	privateURL := buildConnectionURL(protocol, privateEndpoint, password, enableTLS)
+
+	connection := databaseConnection{
+		Protocol: protocol,
+		TLS:      enableTLS,
+		Public:   buildConnectionEndpoint(publicEndpoint, publicURL),
+		Private:  buildConnectionEndpoint(privateEndpoint, privateURL),
+	}
+	if protocol != "memcached" {
+		connection.Username = defaultDatabaseUsername
+		connection.Password = password
+	}
+
+	connectionJSON, err := json.Marshal(connection)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	return publicURL, privateURL, string(connectionJSON), nil
+}
+
+// The user that the database password belongs to.
+const defaultDatabaseUsername = "default"
+
+type databaseConnection struct {
+	Protocol string                      `json:"protocol"`
+	TLS      bool                        `json:"tls"`
+	Username string                      `json:"username,omitempty"`
+	Password string                      `json:"password,omitempty"`
+	Public   *databaseConnectionEndpoint `json:"public,omitempty"`
+	Private  *databaseConnectionEndpoint `json:"private,omitempty"`
+}
+
+type databaseConnectionEndpoint struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	URL  string `json:"url"`
+}
+
+func buildConnectionEndpoint(endpoint string, connectionURL string) *databaseConnectionEndpoint {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		return nil
+	}
+	return &databaseConnectionEndpoint{
+		Host: host,
+		Port: portNumber,
+		URL:  connectionURL,
+	}
+}
+
+// buildConnectionURL returns a URL that clients can connect to the database with, or an empty string if the database
+// doesn't have that endpoint. Memcached databases don't use the Redis password, so no credentials are embedded.
+func buildConnectionURL(protocol string, endpoint string, password string, enableTLS bool) string {
+	if endpoint == "" {
+		return ""
+	}
+
+	u := url.URL{
+		Scheme: "redis",
+		Host:   endpoint,
+	}
+
+	if protocol == "memcached" {
+		u.Scheme = "memcached"
+		return u.String()
+	}
+
+	if enableTLS {
+		u.Scheme = "rediss"
+	}
+	if password != "" {
+		u.User = url.UserPassword(defaultDatabaseUsername, password)
+	}
+
+	return u.String()
+}
+
+// validateClientSSLCertificate checks that the certificate is a PEM encoded X.509 certificate and warns when it has
+// expired or is about to, as the API would otherwise accept a certificate that no client can authenticate with.
+func validateClientSSLCertificate(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %+v to be string", path)
+	}
+	if v == "" {
+		return diags
+	}
+
+	block, _ := pem.Decode([]byte(v))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "client_ssl_certificate must be a PEM encoded certificate",
+			AttributePath: path,
+		})
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "client_ssl_certificate could not be parsed",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	now := time.Now()
+	if now.After(cert.NotAfter) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "client_ssl_certificate has expired",
+			Detail:        fmt.Sprintf("The certificate expired on %s.", cert.NotAfter.Format(time.RFC3339)),
+			AttributePath: path,
+		})
+	} else if now.Add(clientSSLCertificateExpiryWarning).After(cert.NotAfter) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "client_ssl_certificate expires soon",
+			Detail:        fmt.Sprintf("The certificate expires on %s.", cert.NotAfter.Format(time.RFC3339)),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+func flattenSourceIPs(sourceIPs []*string) []string {
+	if len(sourceIPs) == 1 && redis.StringValue(sourceIPs[0]) == "0.0.0.0/0" {
+		// The API handles an empty list as ["0.0.0.0/0"] but need to be careful to match the input to avoid Terraform detecting drift
+		return nil
+	}
+	return redis.StringSliceValue(sourceIPs...)
 }
 
 func toDatabaseId(id string) (int, int, error) {
@@ -595,3 +1390,192 @@ func toDatabaseId(id string) (int, int, error) {
 
 	return subId, dbId, nil
 }
+
+// resourceRedisCloudSubscriptionDatabaseV0 is the schema of the version 0 state, written by v1.0.x of the provider.
+// The SDK decodes flatmap states with it, so it must declare every attribute of that version.
+func resourceRedisCloudSubscriptionDatabaseV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subscription_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"memory_limit_in_gb": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"support_oss_cluster_api": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"external_endpoint_for_oss_cluster_api": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"data_persistence": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "none",
+			},
+			"data_eviction": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "volatile-lru",
+			},
+			"replication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"throughput_measurement_by": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"throughput_measurement_value": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"average_item_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				Computed:  true,
+			},
+			"public_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_ssl_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"periodic_backup_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"replica_of": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"alert": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"modules": {
+				Type:       schema.TypeSet,
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Optional:   true,
+				ForceNew:   true,
+				MinItems:   1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"source_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hashing_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enable_tls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceRedisCloudSubscriptionDatabaseStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	switch subId := rawState["subscription_id"].(type) {
+	case float64:
+		// State upgraders can't return diagnostics to the user, so the deprecation is only reported in the logs. It's
+		// documented in the resource docs and the changelog instead.
+		log.Printf("[WARN] subscription_id of database %v is stored as a number, which is deprecated. Upgrading it to a string", rawState["id"])
+		rawState["subscription_id"] = strconv.Itoa(int(subId))
+	case string:
+		// Already in the current format.
+	case nil:
+		// Fall back on the ID, which is in the format <subscription ID>/<database ID>.
+		if id, ok := rawState["id"].(string); ok {
+			subId, _, err := toDatabaseId(id)
+			if err != nil {
+				return nil, err
+			}
+			rawState["subscription_id"] = strconv.Itoa(subId)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected type %T for subscription_id", subId)
+	}
+
+	return rawState, nil
+}
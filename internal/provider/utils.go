@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"github.com/RedisLabs/rediscloud-go-api/redis"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
 	"sync"
 )
 
@@ -82,3 +88,98 @@ func (m *perIdLock) get(id int) *sync.Mutex {
 func buildResourceId(subId int, id int) string {
 	return fmt.Sprintf("%d/%d", subId, id)
 }
+
+// Prefix of the salted hashes which are stored in the state instead of secrets, when hash_secrets is enabled.
+const secretHashPrefix = "sha256:"
+
+// hashSecret returns a salted hash of the secret in the format `sha256:<salt>:<hash>`. The salt of the previous hash is
+// reused when there is one, so that an unchanged secret keeps the same hash.
+func hashSecret(secret string, previous string) (string, error) {
+	salt, ok := secretHashSalt(previous)
+	if !ok {
+		salt = make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+	}
+	return buildSecretHash(secret, salt), nil
+}
+
+func buildSecretHash(secret string, salt []byte) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(secret))
+	return secretHashPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+func secretHashSalt(hash string) ([]byte, bool) {
+	if !strings.HasPrefix(hash, secretHashPrefix) {
+		return nil, false
+	}
+	parts := strings.Split(strings.TrimPrefix(hash, secretHashPrefix), ":")
+	if len(parts) != 2 {
+		return nil, false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil || len(salt) == 0 {
+		return nil, false
+	}
+	return salt, true
+}
+
+func isSecretHash(value string) bool {
+	_, ok := secretHashSalt(value)
+	return ok
+}
+
+func secretMatchesHash(secret string, hash string) bool {
+	salt, ok := secretHashSalt(hash)
+	if !ok {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(buildSecretHash(secret, salt)), []byte(hash)) == 1
+}
+
+// suppressHashedSecretDiff hides the difference between a secret in the configuration and its hash in the state, as
+// long as hashing is enabled. Otherwise the diff restores the plaintext in the state.
+func suppressHashedSecretDiff(_, old, new string, d *schema.ResourceData) bool {
+	return hashSecretsEnabled(d) && new != "" && secretMatchesHash(new, old)
+}
+
+// hashSecretsEnabled returns whether the resource stores hashes of its secrets, preferring the configured value of
+// hash_secrets to the one in the state, so that disabling it is taken into account by the diff.
+func hashSecretsEnabled(d *schema.ResourceData) bool {
+	config := d.GetRawConfig()
+	if !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr("hash_secrets"); v.IsKnown() && !v.IsNull() {
+			return v.True()
+		}
+	}
+	return d.Get("hash_secrets").(bool)
+}
+
+// secretValue returns the plaintext of a secret attribute from the configuration, as the planned value is the hash in
+// the state when the secret hasn't changed.
+func secretValue(d *schema.ResourceData, key string) string {
+	config := d.GetRawConfig()
+	if !config.IsNull() && config.IsKnown() {
+		if v := config.GetAttr(key); v.IsKnown() && !v.IsNull() {
+			return v.AsString()
+		}
+	}
+	return d.Get(key).(string)
+}
+
+// customizeDiffHashSecrets uses the provider's hash_secrets setting for resources which don't set their own.
+func customizeDiffHashSecrets(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() || !config.GetAttr("hash_secrets").IsNull() {
+		return nil
+	}
+
+	hashSecrets := meta.(*apiClient).hashSecrets
+	if diff.Get("hash_secrets").(bool) == hashSecrets {
+		return nil
+	}
+	return diff.SetNew("hash_secrets", hashSecrets)
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSecret(t *testing.T) {
+	hash, err := hashSecret("s3cret", "")
+	assert.NoError(t, err)
+	assert.True(t, isSecretHash(hash))
+	assert.NotContains(t, hash, "s3cret")
+	assert.True(t, secretMatchesHash("s3cret", hash))
+	assert.False(t, secretMatchesHash("other", hash))
+
+	// The salt of the previous hash is reused, so an unchanged secret doesn't cause a diff.
+	again, err := hashSecret("s3cret", hash)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, again)
+
+	// A new salt is generated for each secret.
+	other, err := hashSecret("s3cret", "")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash, other)
+}
+
+func TestSuppressHashedSecretDiff(t *testing.T) {
+	hash, err := hashSecret("s3cret", "")
+	assert.NoError(t, err)
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"hash_secrets": {Type: schema.TypeBool, Optional: true},
+	}, map[string]interface{}{"hash_secrets": true})
+
+	assert.True(t, suppressHashedSecretDiff("password", hash, "s3cret", d))
+	assert.False(t, suppressHashedSecretDiff("password", hash, "changed", d))
+	assert.False(t, suppressHashedSecretDiff("password", "s3cret", "changed", d))
+	assert.False(t, suppressHashedSecretDiff("password", "sha256:nothex:abc", "s3cret", d))
+	assert.False(t, isSecretHash("s3cret"))
+}
+
+// Checks that turning hashing off plans to replace the hash in the state with the plaintext from the configuration.
+func TestSuppressHashedSecretDiffHashingDisabled(t *testing.T) {
+	hash, err := hashSecret("s3cret", "")
+	assert.NoError(t, err)
+
+	for _, hashSecrets := range []bool{true, false} {
+		r := testDatabaseResource()
+		values := map[string]interface{}{
+			"subscription_id":              "1",
+			"name":                         "example",
+			"protocol":                     "redis",
+			"memory_limit_in_gb":           1.0,
+			"throughput_measurement_by":    "operations-per-second",
+			"throughput_measurement_value": 1000,
+			"hash_secrets":                 true,
+			"password":                     hash,
+		}
+		current := schema.TestResourceDataRaw(t, r.Schema, values)
+		current.SetId("1/2")
+		state := current.State()
+
+		config := testDatabaseConfig(r, map[string]cty.Value{
+			"subscription_id":              cty.StringVal("1"),
+			"name":                         cty.StringVal("example"),
+			"protocol":                     cty.StringVal("redis"),
+			"memory_limit_in_gb":           cty.NumberFloatVal(1),
+			"throughput_measurement_by":    cty.StringVal("operations-per-second"),
+			"throughput_measurement_value": cty.NumberIntVal(1000),
+			"hash_secrets":                 cty.BoolVal(hashSecrets),
+			"password":                     cty.StringVal("s3cret"),
+		})
+		state.RawConfig = config
+		diff, err := r.SimpleDiff(context.TODO(), state, terraform.NewResourceConfigShimmed(config, r.CoreConfigSchema()), nil)
+		assert.NoError(t, err)
+
+		var password *terraform.ResourceAttrDiff
+		if diff != nil {
+			password = diff.Attributes["password"]
+		}
+		if hashSecrets {
+			assert.Nil(t, password)
+		} else if assert.NotNil(t, password) {
+			assert.Equal(t, hash, password.Old)
+			assert.Equal(t, "s3cret", password.New)
+		}
+	}
+}
@@ -3,9 +3,13 @@ package main
 import (
 	"context"
 	"flag"
-	"github.com/RedisLabs/terraform-provider-rediscloud/internal/provider"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 	"log"
+
+	"github.com/RedisLabs/terraform-provider-rediscloud/internal/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
 )
 
 var (
@@ -23,18 +27,29 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.New(version)}
-
 	// Prevent logger from prepending date/time to logs, which breaks log-level parsing/filtering
 	log.SetFlags(0)
 
+	ctx := context.Background()
+
+	// The resources and data sources built on the SDK and on the plugin framework are served by the same provider.
+	providers := []func() tfprotov5.ProviderServer{
+		provider.New(version)().GRPCProvider,
+		providerserver.NewProtocol5(provider.NewFramework(version)()),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var serveOpts []tf5server.ServeOpt
 	if debugMode {
-		err := plugin.Debug(context.Background(), "RedisLabs/rediscloud", opts)
-		if err != nil {
-			log.Fatal(err.Error())
-		}
-		return
+		serveOpts = append(serveOpts, tf5server.WithManagedDebug())
 	}
 
-	plugin.Serve(opts)
+	err = tf5server.Serve("registry.terraform.io/RedisLabs/rediscloud", muxServer.ProviderServer, serveOpts...)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
 }